@@ -159,6 +159,7 @@ func execute(cfg *config) int {
 			builders.NewCaskBuilder(nil),
 			builders.NewGoBuilder(nil),
 			builders.NewCPANBuilder(nil),
+			builders.NewAURBuilder(nil),
 		}
 		d = seed.NewDisambiguator(allProbers, 30*time.Second)
 	}
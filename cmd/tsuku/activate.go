@@ -5,8 +5,7 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tsukumogami/tsuku/internal/config"
-	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/pkg/tsuku"
 )
 
 var activateCmd = &cobra.Command{
@@ -32,17 +31,12 @@ func runActivate(cmd *cobra.Command, args []string) {
 	toolName := args[0]
 	version := args[1]
 
-	// Load config
-	cfg, err := config.DefaultConfig()
+	mgr, err := tsuku.NewManager(tsuku.Options{})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
 		exitWithCode(ExitGeneral)
 	}
 
-	// Create manager
-	mgr := install.New(cfg)
-
-	// Activate the version
 	if err := mgr.Activate(toolName, version); err != nil {
 		printError(err)
 		exitWithCode(ExitGeneral)
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/pkg/tsuku"
+)
+
+var cacheListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed tool versions in the store",
+	Long: `List every installed tool version in the store, including versions
+that are not currently active. This is the version-level view that
+"tsuku cache prune" and "tsuku cache use" operate on.`,
+	Run: runCacheList,
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune [tool@version ...]",
+	Short: "Remove installed tool versions from the store",
+	Long: `Remove installed tool versions from the store using composable
+selectors. Selectors can be combined: --keep protects the newest N versions
+of every tool touched by the other selectors, while --older-than and
+--unused-since pick removal candidates by age. Positional arguments select
+specific versions, either exactly ("ripgrep@14.1.0") or by semver range
+("ripgrep@~14.0"). The currently active version of a tool is never removed.
+
+Examples:
+  tsuku cache prune --keep=2
+  tsuku cache prune --older-than=90d
+  tsuku cache prune --keep=2 --older-than=30d
+  tsuku cache prune ripgrep@14.1.0
+  tsuku cache prune "ripgrep@~14.0" --dry-run`,
+	Run: runCachePrune,
+}
+
+var cacheUseCmd = &cobra.Command{
+	Use:   "use <tool>@<version>",
+	Short: "Activate an installed tool version",
+	Long: `Activate an installed tool version, the same as "tsuku activate"
+but using the "tool@version" selector syntax shared with cache list/prune.
+
+Example:
+  tsuku cache use ripgrep@14.1.0`,
+	Args: cobra.ExactArgs(1),
+	Run:  runCacheUse,
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheListCmd)
+	cacheCmd.AddCommand(cachePruneCmd)
+	cacheCmd.AddCommand(cacheUseCmd)
+
+	cacheListCmd.Flags().Bool("json", false, "Output in JSON format")
+
+	cachePruneCmd.Flags().Int("keep", 0, "Keep the N newest versions of every matched tool")
+	cachePruneCmd.Flags().String("older-than", "", "Remove versions installed more than this long ago (e.g. 90d, 24h)")
+	cachePruneCmd.Flags().String("unused-since", "", "Remove versions not activated within this long (e.g. 30d)")
+	cachePruneCmd.Flags().Bool("dry-run", false, "Show what would be removed without deleting")
+	cachePruneCmd.Flags().Bool("json", false, "Output in JSON format")
+}
+
+func runCacheList(cmd *cobra.Command, args []string) {
+	cfg, err := config.DefaultConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
+		exitWithCode(ExitGeneral)
+	}
+
+	mgr := install.New(cfg)
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	tools, err := mgr.ListAll()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to list tools: %v\n", err)
+		exitWithCode(ExitGeneral)
+	}
+
+	if jsonOutput {
+		type versionJSON struct {
+			Name     string `json:"name"`
+			Version  string `json:"version"`
+			Path     string `json:"path"`
+			IsActive bool   `json:"is_active"`
+		}
+		output := make([]versionJSON, 0, len(tools))
+		for _, t := range tools {
+			output = append(output, versionJSON{Name: t.Name, Version: t.Version, Path: t.Path, IsActive: t.IsActive})
+		}
+		printJSON(output)
+		return
+	}
+
+	if len(tools) == 0 {
+		printInfo("No tools installed.")
+		return
+	}
+
+	printInfof("Installed tool versions (%d total):\n\n", len(tools))
+	for _, t := range tools {
+		activeIndicator := ""
+		if t.IsActive {
+			activeIndicator = " (active)"
+		}
+		fmt.Printf("  %-20s  %s%s\n", t.Name, t.Version, activeIndicator)
+	}
+}
+
+func runCachePrune(cmd *cobra.Command, args []string) {
+	cfg, err := config.DefaultConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
+		exitWithCode(ExitGeneral)
+	}
+
+	keep, _ := cmd.Flags().GetInt("keep")
+	olderThanStr, _ := cmd.Flags().GetString("older-than")
+	unusedSinceStr, _ := cmd.Flags().GetString("unused-since")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	selector := install.PruneSelector{Keep: keep, DryRun: dryRun}
+
+	if olderThanStr != "" {
+		d, err := parseDuration(olderThanStr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --older-than value: %v\n", err)
+			exitWithCode(ExitGeneral)
+		}
+		selector.OlderThan = d
+	}
+	if unusedSinceStr != "" {
+		d, err := parseDuration(unusedSinceStr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --unused-since value: %v\n", err)
+			exitWithCode(ExitGeneral)
+		}
+		selector.UnusedSince = d
+	}
+	for _, arg := range args {
+		if isSemverRangeSelector(arg) {
+			selector.SemverRange = append(selector.SemverRange, arg)
+		} else {
+			selector.Exact = append(selector.Exact, arg)
+		}
+	}
+
+	mgr := install.New(cfg)
+	result, err := mgr.Prune(context.Background(), selector)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to prune store: %v\n", err)
+		exitWithCode(ExitGeneral)
+	}
+
+	if jsonOutput {
+		printJSON(result)
+		return
+	}
+
+	if len(result.Removed) == 0 {
+		printInfo("Nothing to prune.")
+		return
+	}
+
+	verb := "Removed"
+	if dryRun {
+		verb = "Would remove"
+	}
+	for _, d := range result.Removed {
+		fmt.Printf("  %s %s@%s (%s, %s)\n", verb, d.Tool, d.Version, d.Reason, formatBytes(d.Bytes))
+	}
+	fmt.Println()
+	fmt.Printf("%s %d versions, reclaiming %s.\n", verb, len(result.Removed), formatBytes(result.BytesReclaimed))
+}
+
+func runCacheUse(cmd *cobra.Command, args []string) {
+	tool, version, err := splitCacheSelector(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		exitWithCode(ExitGeneral)
+	}
+
+	mgr, err := tsuku.NewManager(tsuku.Options{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
+		exitWithCode(ExitGeneral)
+	}
+
+	if err := mgr.Activate(tool, version); err != nil {
+		printError(err)
+		exitWithCode(ExitGeneral)
+	}
+
+	fmt.Printf("Activated %s version %s\n", tool, version)
+}
+
+// splitCacheSelector splits a "tool@version" selector into its two parts.
+func splitCacheSelector(selector string) (tool, version string, err error) {
+	idx := strings.Index(selector, "@")
+	if idx <= 0 || idx == len(selector)-1 {
+		return "", "", fmt.Errorf("invalid selector %q: expected \"tool@version\"", selector)
+	}
+	return selector[:idx], selector[idx+1:], nil
+}
+
+// isSemverRangeSelector reports whether a "tool@..." prune argument names a
+// semver constraint rather than an exact version, based on the presence of
+// range operators in the selector portion.
+func isSemverRangeSelector(selector string) bool {
+	idx := strings.Index(selector, "@")
+	if idx == -1 {
+		return false
+	}
+	return strings.ContainsAny(selector[idx+1:], "~^<>= |")
+}
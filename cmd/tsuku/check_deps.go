@@ -90,6 +90,9 @@ func runCheckDeps(cmd *cobra.Command, args []string) {
 		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
 		exitWithCode(ExitGeneral)
 	}
+	// Stays on install.New rather than pkg/tsuku.Manager: mgr is threaded
+	// into checkDependency/checkProvisionableDependency as *install.Manager,
+	// which pkg/tsuku intentionally doesn't re-expose.
 	mgr := install.New(cfg)
 
 	// Check each dependency
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tsukumogami/tsuku/internal/builders"
 	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/discover"
 	"github.com/tsukumogami/tsuku/internal/install"
 	"github.com/tsukumogami/tsuku/internal/recipe"
 	"github.com/tsukumogami/tsuku/internal/toolchain"
@@ -80,10 +81,11 @@ Examples:
 }
 
 var (
-	createFrom           string
-	createForce          bool
-	createAutoApprove    bool
-	createSkipValidation bool
+	createFrom            string
+	createForce           bool
+	createAutoApprove     bool
+	createSkipValidation  bool
+	createAllowVulnerable bool
 )
 
 func init() {
@@ -91,9 +93,38 @@ func init() {
 	createCmd.Flags().BoolVar(&createForce, "force", false, "Overwrite existing local recipe")
 	createCmd.Flags().BoolVar(&createAutoApprove, "yes", false, "Skip recipe preview confirmation")
 	createCmd.Flags().BoolVar(&createSkipValidation, "skip-validation", false, "Skip container validation (use when Docker is unavailable)")
+	createCmd.Flags().BoolVar(&createAllowVulnerable, "allow-vulnerable", false, "Create the recipe even if the package has known HIGH/CRITICAL vulnerabilities")
 	_ = createCmd.MarkFlagRequired("from")
 }
 
+// checkOSVVulnerabilities queries OSV for known advisories against
+// (builder, toolName) and returns a *discover.VulnerableSourceWarning if the
+// highest severity found is HIGH or CRITICAL and --allow-vulnerable wasn't
+// passed. A failed OSV query is a soft failure: it's logged and recipe
+// creation proceeds, matching how discover.EcosystemProbe treats OSV misses.
+func checkOSVVulnerabilities(ctx context.Context, builderName, toolName string) error {
+	cfg, err := config.DefaultConfig()
+	if err != nil {
+		return nil
+	}
+
+	vulns, err := discover.NewOSVProbe(cfg.OSVCacheDir).Check(ctx, builderName, toolName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to check %s for known vulnerabilities: %v\n", toolName, err)
+		return nil
+	}
+	if createAllowVulnerable || discover.HighestSeverity(vulns) < discover.SeverityHigh {
+		return nil
+	}
+
+	return &discover.VulnerableSourceWarning{
+		Tool:            toolName,
+		Builder:         builderName,
+		Source:          toolName,
+		Vulnerabilities: vulns,
+	}
+}
+
 // confirmSkipValidation prompts the user to confirm skipping validation.
 // Returns true if the user consents, false otherwise.
 func confirmSkipValidation() bool {
@@ -276,6 +307,11 @@ func runCreate(cmd *cobra.Command, args []string) {
 			fmt.Fprintf(os.Stderr, "Error: package '%s' not found in %s\n", toolName, builderName)
 			exitWithCode(ExitRecipeNotFound)
 		}
+
+		if err := checkOSVVulnerabilities(ctx, builderName, toolName); err != nil {
+			printError(err)
+			exitWithCode(ExitGeneral)
+		}
 	}
 
 	// Build the recipe
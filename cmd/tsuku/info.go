@@ -9,6 +9,7 @@ import (
 	"github.com/tsukumogami/tsuku/internal/actions"
 	"github.com/tsukumogami/tsuku/internal/config"
 	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/pkg/tsuku"
 )
 
 var infoCmd = &cobra.Command{
@@ -33,8 +34,11 @@ var infoCmd = &cobra.Command{
 		status := "not_installed"
 		cfg, err := config.DefaultConfig()
 		if err == nil {
-			mgr := install.New(cfg)
-			tools, _ := mgr.List()
+			mgr, mgrErr := tsuku.NewManager(tsuku.Options{Config: cfg})
+			var tools []install.InstalledTool
+			if mgrErr == nil {
+				tools, _ = mgr.List()
+			}
 
 			for _, t := range tools {
 				if t.Name == toolName {
@@ -17,6 +17,9 @@ var installFresh bool
 var installPlanPath string
 var installSandbox bool
 var installRecipePath string
+var installPrintPlan string
+var installExplain bool
+var installStrict bool
 
 var installCmd = &cobra.Command{
 	Use:   "install [tool]...",
@@ -33,6 +36,11 @@ Install from a pre-computed plan:
   tsuku install --plan plan.json
   tsuku eval rg | tsuku install --plan -
 
+Audit a plan before applying it:
+  tsuku install --plan plan.json --dry-run
+  tsuku install --plan plan.json --print-plan=toml
+  tsuku install --plan plan.json --explain --strict
+
 Test installation in a sandbox container:
   tsuku install kubectl --sandbox
   tsuku install --recipe ./my-recipe.toml --sandbox
@@ -86,18 +94,63 @@ Test installation in a sandbox container:
 				exitWithCode(ExitUsage)
 			}
 
-			// Dry-run is not supported with --plan (plan already exists)
-			if installDryRun {
-				printError(fmt.Errorf("--dry-run is not supported with --plan (plan already exists)"))
-				exitWithCode(ExitUsage)
-			}
-
 			// Tool name is optional - defaults to plan's tool name
 			var toolName string
 			if len(args) == 1 {
 				toolName = args[0]
 			}
 
+			// --print-plan and --explain are read-only audits of the plan and
+			// take precedence over actually applying it.
+			if installPrintPlan != "" {
+				plan, err := loadPlanFromSource(installPlanPath)
+				if err != nil {
+					printError(err)
+					exitWithCode(ExitInstallFailed)
+				}
+				if err := validateExternalPlan(plan, toolName); err != nil {
+					printError(err)
+					exitWithCode(ExitUsage)
+				}
+				if installStrict {
+					if err := validateExternalPlanStrict(plan); err != nil {
+						printError(err)
+						exitWithCode(ExitUsage)
+					}
+				}
+				if err := runPlanPrint(plan, installPrintPlan); err != nil {
+					printError(err)
+					exitWithCode(ExitInstallFailed)
+				}
+				return
+			}
+
+			if installExplain {
+				plan, err := loadPlanFromSource(installPlanPath)
+				if err != nil {
+					printError(err)
+					exitWithCode(ExitInstallFailed)
+				}
+				if err := runPlanExplain(plan, toolName, installStrict); err != nil {
+					printError(err)
+					exitWithCode(ExitInstallFailed)
+				}
+				return
+			}
+
+			if installDryRun {
+				plan, err := loadPlanFromSource(installPlanPath)
+				if err != nil {
+					printError(err)
+					exitWithCode(ExitInstallFailed)
+				}
+				if err := runPlanDryRun(plan, toolName, installStrict); err != nil {
+					printError(err)
+					exitWithCode(ExitInstallFailed)
+				}
+				return
+			}
+
 			if err := runPlanBasedInstall(installPlanPath, toolName); err != nil {
 				printError(err)
 				exitWithCode(ExitInstallFailed)
@@ -105,6 +158,11 @@ Test installation in a sandbox container:
 			return
 		}
 
+		if installPrintPlan != "" || installExplain {
+			printError(fmt.Errorf("--print-plan and --explain require --plan"))
+			exitWithCode(ExitUsage)
+		}
+
 		// Normal installation: require at least one tool
 		if len(args) == 0 {
 			printError(fmt.Errorf("requires at least 1 arg(s), only received 0"))
@@ -155,6 +213,9 @@ func init() {
 	installCmd.Flags().StringVar(&installPlanPath, "plan", "", "Install from a pre-computed plan file (use '-' for stdin)")
 	installCmd.Flags().BoolVar(&installSandbox, "sandbox", false, "Run installation in an isolated container for testing")
 	installCmd.Flags().StringVar(&installRecipePath, "recipe", "", "Path to a local recipe file (for testing)")
+	installCmd.Flags().StringVar(&installPrintPlan, "print-plan", "", "Print the resolved plan (json or toml) and exit, without installing; requires --plan")
+	installCmd.Flags().BoolVar(&installExplain, "explain", false, "Print a step-by-step trace of what --plan would do, without installing")
+	installCmd.Flags().BoolVar(&installStrict, "strict", false, "Reject plans with a format version newer than this binary supports")
 }
 
 // isInteractive returns true if stdin is connected to a terminal
@@ -14,8 +14,10 @@ import (
 	"github.com/tsukumogami/tsuku/internal/config"
 	"github.com/tsukumogami/tsuku/internal/executor"
 	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/internal/notify"
 	"github.com/tsukumogami/tsuku/internal/recipe"
 	"github.com/tsukumogami/tsuku/internal/telemetry"
+	"github.com/tsukumogami/tsuku/internal/userconfig"
 	"github.com/tsukumogami/tsuku/internal/validate"
 )
 
@@ -222,12 +224,27 @@ func findDependencyBinPath(mgr *install.Manager, depName string) (string, error)
 	return binDir, nil
 }
 
+// newConfiguredNotifier builds a notify.Dispatcher from the user's
+// [[notifications]] config, or nil if none are configured. Callers that get
+// a non-nil Dispatcher are responsible for Start/Stop around the work they
+// want observed.
+func newConfiguredNotifier(cfg *config.Config) *notify.Dispatcher {
+	userCfg, err := userconfig.Load()
+	if err != nil || len(userCfg.Notifications) == 0 {
+		return nil
+	}
+	return notify.NewDispatcher(userCfg.Notifications, cfg.NotifySpoolDir)
+}
+
 func installWithDependencies(toolName, reqVersion, versionConstraint string, isExplicit bool, parent string, visited map[string]bool, telemetryClient *telemetry.Client) error {
 	// Initialize manager for state updates
 	cfg, err := config.DefaultConfig()
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
+	// Stays on install.New rather than pkg/tsuku.Manager: this path relies
+	// on GetState/GetInstalledLibraryVersion/IsVersionInstalled/
+	// InstallWithOptions, none of which pkg/tsuku re-exposes.
 	mgr := install.New(cfg)
 
 	// If explicit install, check if tool is hidden and just expose it
@@ -303,6 +320,13 @@ func installWithDependencies(toolName, reqVersion, versionConstraint string, isE
 		return err
 	}
 
+	notifier := newConfiguredNotifier(cfg)
+	if notifier != nil {
+		notifier.Start()
+		defer notifier.Stop()
+		notifier.Emit(notify.NewRecipeFetchedEvent(toolName, ""))
+	}
+
 	// Validate the recipe before attempting installation
 	// This runs the same validation as `tsuku validate` to catch issues early
 	validationResult := recipe.ValidateRecipe(r)
@@ -430,6 +454,11 @@ func installWithDependencies(toolName, reqVersion, versionConstraint string, isE
 	// Set key cache directory for PGP signature verification
 	exec.SetKeyCacheDir(cfg.KeyCacheDir)
 
+	// Deliver step/plan lifecycle events to any configured notification endpoints.
+	if notifier != nil {
+		exec.SetNotifier(notifier)
+	}
+
 	// Look up resolved dependency versions for variable expansion.
 	// This is needed because dependencies are installed before plan generation,
 	// so plan.Dependencies will be empty at execution time.
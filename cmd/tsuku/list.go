@@ -5,27 +5,36 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tsukumogami/tsuku/internal/config"
 	"github.com/tsukumogami/tsuku/internal/install"
 )
 
+// listOutputFormats are the values --format accepts. "json" is kept
+// alongside the older --json flag for backward compatibility.
+var listOutputFormats = []string{"text", "json", "spdx-json", "cyclonedx-json"}
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List installed tools",
 	Long:  `List all tools currently installed by tsuku.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.DefaultConfig()
+		mgr, err := install.NewManager(install.Options{})
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
 			exitWithCode(ExitGeneral)
 		}
 
-		mgr := install.New(cfg)
-
 		// Check flags
 		showSystemDeps, _ := cmd.Flags().GetBool("show-system-dependencies")
 		showAll, _ := cmd.Flags().GetBool("all")
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		format, _ := cmd.Flags().GetString("format")
+		if jsonOutput {
+			format = "json"
+		}
+		if !isValidListFormat(format) {
+			fmt.Fprintf(os.Stderr, "Unknown --format %q (want one of %v)\n", format, listOutputFormats)
+			exitWithCode(ExitGeneral)
+		}
 
 		var tools []install.InstalledTool
 		if showSystemDeps {
@@ -49,8 +58,13 @@ var listCmd = &cobra.Command{
 			}
 		}
 
+		if format == "spdx-json" || format == "cyclonedx-json" {
+			printSBOM(mgr, tools, libs, format, showSystemDeps)
+			return
+		}
+
 		// JSON output mode
-		if jsonOutput {
+		if format == "json" {
 			type itemJSON struct {
 				Name     string `json:"name"`
 				Version  string `json:"version"`
@@ -134,5 +148,16 @@ var listCmd = &cobra.Command{
 func init() {
 	listCmd.Flags().Bool("show-system-dependencies", false, "Include hidden system dependencies in output")
 	listCmd.Flags().Bool("all", false, "Include libraries in output")
-	listCmd.Flags().Bool("json", false, "Output in JSON format")
+	listCmd.Flags().Bool("json", false, "Output in JSON format (shorthand for --format json)")
+	listCmd.Flags().String("format", "text", "Output format: text, json, spdx-json, or cyclonedx-json")
+}
+
+// isValidListFormat reports whether format is one of listOutputFormats.
+func isValidListFormat(format string) bool {
+	for _, f := range listOutputFormats {
+		if format == f {
+			return true
+		}
+	}
+	return false
 }
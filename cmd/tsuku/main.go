@@ -10,8 +10,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tsukumogami/tsuku/internal/buildinfo"
 	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/discover"
 	"github.com/tsukumogami/tsuku/internal/recipe"
 	"github.com/tsukumogami/tsuku/internal/registry"
+	"github.com/tsukumogami/tsuku/internal/version"
 )
 
 var quietFlag bool
@@ -49,6 +51,11 @@ func init() {
 	// Initialize recipe loader with registry and local recipes directory
 	loader = recipe.NewWithLocalRecipes(reg, cfg.RecipesDir)
 
+	// Give the recipe package's version validator a cached SourceManager so
+	// it can confirm matched sources (currently: npm) exist upstream.
+	sourceManager := discover.NewDefaultSourceManager(discover.SourceManagerConfig{CacheDir: cfg.SourcesDir})
+	version.ConfigureSourceManager(sourceManager)
+
 	// Register all commands
 	rootCmd.AddCommand(activateCmd)
 	rootCmd.AddCommand(cacheCmd)
@@ -69,6 +76,7 @@ func init() {
 	rootCmd.AddCommand(completionCmd)
 	rootCmd.AddCommand(validateCmd)
 	rootCmd.AddCommand(evalCmd)
+	rootCmd.AddCommand(pluginCmd)
 }
 
 func main() {
@@ -6,9 +6,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tsuku-dev/tsuku/internal/config"
-	"github.com/tsuku-dev/tsuku/internal/install"
-	"github.com/tsuku-dev/tsuku/internal/version"
+	"github.com/tsukumogami/tsuku/internal/version"
+	"github.com/tsukumogami/tsuku/pkg/tsuku"
 )
 
 var outdatedCmd = &cobra.Command{
@@ -18,13 +17,12 @@ var outdatedCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		jsonOutput, _ := cmd.Flags().GetBool("json")
 
-		cfg, err := config.DefaultConfig()
+		mgr, err := tsuku.NewManager(tsuku.Options{})
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 			exitWithCode(ExitGeneral)
 		}
 
-		mgr := install.New(cfg)
 		tools, err := mgr.List()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error listing tools: %v\n", err)
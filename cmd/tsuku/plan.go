@@ -71,7 +71,9 @@ func runPlanShow(cmd *cobra.Command, args []string) {
 		exitWithCode(ExitGeneral)
 	}
 
-	// Load state
+	// Load state. Stays on install.New rather than pkg/tsuku.Manager: only
+	// GetState().GetToolState is needed here, and GetState isn't part of
+	// pkg/tsuku's re-exposed surface.
 	mgr := install.New(cfg)
 	toolState, err := mgr.GetState().GetToolState(toolName)
 	if err != nil {
@@ -233,7 +235,9 @@ func getPlanForTool(toolName string) *install.Plan {
 		exitWithCode(ExitGeneral)
 	}
 
-	// Load state
+	// Load state. Stays on install.New rather than pkg/tsuku.Manager: only
+	// GetState().GetToolState is needed here, and GetState isn't part of
+	// pkg/tsuku's re-exposed surface.
 	mgr := install.New(cfg)
 	toolState, err := mgr.GetState().GetToolState(toolName)
 	if err != nil {
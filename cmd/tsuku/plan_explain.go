@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"github.com/tsukumogami/tsuku/internal/executor"
+)
+
+// runPlanDryRun validates plan and reports what runPlanBasedInstall would do,
+// without making any filesystem or network changes. It mirrors
+// runPlanBasedInstall's validation (including --strict) but routes execution
+// through an executor.DryRunExecutor instead of executor.ExecutePlan.
+func runPlanDryRun(plan *executor.InstallationPlan, toolName string, strict bool) error {
+	if err := validateExternalPlan(plan, toolName); err != nil {
+		return err
+	}
+	if strict {
+		if err := validateExternalPlanStrict(plan); err != nil {
+			return err
+		}
+	}
+
+	effectiveToolName := toolName
+	if effectiveToolName == "" {
+		effectiveToolName = plan.Tool
+	}
+
+	dryRun := executor.NewDryRunExecutor()
+	ops, err := dryRun.Plan(plan)
+	if err != nil {
+		return err
+	}
+
+	printInfof("Would install: %s@%s\n", effectiveToolName, plan.Version)
+	printInfof("  Steps: %d\n", len(ops))
+	for _, op := range ops {
+		printInfof("    %d. %s: %s\n", op.Step, op.Action, op.Reason)
+	}
+
+	return nil
+}
+
+// runPlanExplain prints a step-by-step trace of plan, naming which steps
+// would run and why, plus the resolved download/checksum data operators need
+// to audit a plan before applying it.
+func runPlanExplain(plan *executor.InstallationPlan, toolName string, strict bool) error {
+	if err := validateExternalPlan(plan, toolName); err != nil {
+		return err
+	}
+	if strict {
+		if err := validateExternalPlanStrict(plan); err != nil {
+			return err
+		}
+	}
+
+	dryRun := executor.NewDryRunExecutor()
+	ops, err := dryRun.Plan(plan)
+	if err != nil {
+		return err
+	}
+
+	printInfof("Explain plan: %s@%s (format version %d)\n", plan.Tool, plan.Version, plan.FormatVersion)
+	printInfo()
+	for _, op := range ops {
+		status := "run"
+		if op.Skipped {
+			status = "skip"
+		}
+		printInfof("Step %d/%d [%s]: %s\n", op.Step, len(ops), status, op.Action)
+		printInfof("    %s\n", op.Reason)
+		if op.URL != "" {
+			printInfof("    url:      %s\n", op.URL)
+			printInfof("    checksum: %s\n", op.Checksum)
+		}
+	}
+
+	return nil
+}
+
+// runPlanPrint renders plan in the requested format and writes it to stdout.
+// format must be "json" (the on-disk representation) or "toml". "" defaults
+// to "json".
+func runPlanPrint(plan *executor.InstallationPlan, format string) error {
+	if format == "" {
+		format = "json"
+	}
+
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to render plan as json: %w", err)
+		}
+		fmt.Println(string(data))
+	case "toml":
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Encode(plan); err != nil {
+			return fmt.Errorf("failed to render plan as toml: %w", err)
+		}
+		fmt.Print(buf.String())
+	case "yaml":
+		return fmt.Errorf("--print-plan=yaml is not supported yet; use json or toml")
+	default:
+		return fmt.Errorf("unknown --print-plan format %q (want json or toml)", format)
+	}
+
+	return nil
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/tsukumogami/tsuku/internal/config"
 	"github.com/tsukumogami/tsuku/internal/executor"
 	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/internal/notify"
+	"github.com/tsukumogami/tsuku/internal/plansign"
 	"github.com/tsukumogami/tsuku/internal/recipe"
+	"github.com/tsukumogami/tsuku/internal/userconfig"
 )
 
 // runPlanBasedInstall installs a tool from an external plan file or stdin.
@@ -24,6 +27,11 @@ func runPlanBasedInstall(planPath, toolName string) error {
 	if err := validateExternalPlan(plan, toolName); err != nil {
 		return err
 	}
+	if installStrict {
+		if err := validateExternalPlanStrict(plan); err != nil {
+			return err
+		}
+	}
 
 	// Use tool name from plan if not specified on command line
 	effectiveToolName := toolName
@@ -36,6 +44,10 @@ func runPlanBasedInstall(planPath, toolName string) error {
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
+	// Stays on install.New rather than pkg/tsuku.Manager: InstallWithOptions
+	// and GetState().UpdateTool aren't part of pkg/tsuku's re-exposed
+	// surface (pkg/tsuku.Manager.ApplyPlan covers the embeddable equivalent
+	// of this flow).
 	mgr := install.New(cfg)
 
 	// Create minimal recipe for executor context
@@ -59,6 +71,35 @@ func runPlanBasedInstall(planPath, toolName string) error {
 	// Set tools directory for finding other installed tools
 	exec.SetToolsDir(cfg.ToolsDir)
 
+	// Wire signed-plan policy: a sidecar .sig file next to a real plan path
+	// (stdin plans can't have one), trust.toml's per-source policy, and the
+	// require_signed_plans override in config.toml.
+	userCfg, err := userconfig.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load user config: %w", err)
+	}
+	trust, err := plansign.LoadTrustPolicy(cfg.TrustFile)
+	if err != nil {
+		return fmt.Errorf("failed to load trust policy: %w", err)
+	}
+	exec.SetRequireSignedPlans(userCfg.RequireSignedPlans)
+	exec.SetTrustPolicy(trust)
+	if planPath != "-" {
+		sig, err := plansign.ReadSignatureFile(planPath)
+		if err != nil {
+			return fmt.Errorf("failed to read plan signature: %w", err)
+		}
+		exec.SetPlanSignature(sig)
+	}
+
+	// Deliver step/plan lifecycle events to any configured notification endpoints.
+	if len(userCfg.Notifications) > 0 {
+		notifier := notify.NewDispatcher(userCfg.Notifications, cfg.NotifySpoolDir)
+		notifier.Start()
+		defer notifier.Stop()
+		exec.SetNotifier(notifier)
+	}
+
 	printInfof("Installing %s@%s from plan...\n", effectiveToolName, plan.Version)
 
 	// Execute the plan
@@ -54,3 +54,14 @@ func validateExternalPlan(plan *executor.InstallationPlan, toolName string) erro
 
 	return nil
 }
+
+// validateExternalPlanStrict rejects plans whose format version is newer than
+// this binary's executor.PlanFormatVersion, so operators can safely audit plans
+// generated by a newer tsuku client before feeding them to `tsuku install`.
+func validateExternalPlanStrict(plan *executor.InstallationPlan) error {
+	if plan.FormatVersion > executor.PlanFormatVersion {
+		return fmt.Errorf("plan format version %d is newer than this binary supports (max %d); upgrade tsuku before applying this plan",
+			plan.FormatVersion, executor.PlanFormatVersion)
+	}
+	return nil
+}
@@ -24,7 +24,7 @@ func TestLoadPlanFromSource_File(t *testing.T) {
 			{
 				Action:    "download",
 				Params:    map[string]interface{}{"url": "https://example.com/file.tar.gz"},
-				Checksum:  "abc123",
+				Checksum:  executor.Hash{Algorithm: executor.HashAlgorithmSHA256, Value: "abc123"},
 				Evaluable: true,
 			},
 		},
@@ -149,7 +149,7 @@ func TestValidateExternalPlan_Valid(t *testing.T) {
 			{
 				Action:   "download",
 				Params:   map[string]interface{}{"url": "https://example.com/file.tar.gz"},
-				Checksum: "abc123",
+				Checksum: executor.Hash{Algorithm: executor.HashAlgorithmSHA256, Value: "abc123"},
 			},
 			{
 				Action: "extract",
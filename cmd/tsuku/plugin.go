@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/install"
+)
+
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage external action plugins",
+	Long:  `Manage out-of-process action plugins discovered under ~/.tsuku/plugins.`,
+}
+
+var pluginListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List loaded plugins",
+	Long:  `List all external action plugins discovered at startup and which actions they contribute.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.DefaultConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
+			exitWithCode(ExitGeneral)
+		}
+
+		// Stays on install.New rather than pkg/tsuku.Manager: Plugins isn't
+		// part of pkg/tsuku's re-exposed surface.
+		mgr := install.New(cfg)
+		plugins := mgr.Plugins()
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		if jsonOutput {
+			type actionJSON struct {
+				Name      string `json:"name"`
+				Evaluable bool   `json:"evaluable"`
+			}
+			type pluginJSON struct {
+				Name    string       `json:"name"`
+				Version string       `json:"version"`
+				Dir     string       `json:"dir"`
+				Actions []actionJSON `json:"actions"`
+			}
+			output := make([]pluginJSON, 0, len(plugins))
+			for _, p := range plugins {
+				pj := pluginJSON{Name: p.Manifest.Name, Version: p.Manifest.Version, Dir: p.Dir}
+				for _, a := range p.Manifest.Actions {
+					pj.Actions = append(pj.Actions, actionJSON{Name: a.Name, Evaluable: a.Evaluable})
+				}
+				output = append(output, pj)
+			}
+			printJSON(output)
+			return
+		}
+
+		if len(plugins) == 0 {
+			printInfo("No plugins loaded.")
+			return
+		}
+
+		printInfof("Loaded plugins (%d total):\n\n", len(plugins))
+		for _, p := range plugins {
+			fmt.Printf("  %-20s  %s\n", p.Manifest.Name, p.Manifest.Version)
+			for _, a := range p.Manifest.Actions {
+				evaluable := ""
+				if a.Evaluable {
+					evaluable = " (evaluable)"
+				}
+				fmt.Printf("      %s%s\n", a.Name, evaluable)
+			}
+		}
+	},
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginListCmd)
+	pluginListCmd.Flags().Bool("json", false, "Output in JSON format")
+}
@@ -33,6 +33,10 @@ Examples:
 			os.Exit(1)
 		}
 
+		// Stays on install.New rather than pkg/tsuku.Manager: the
+		// surrounding state cleanup (GetState().Load/RemoveTool/
+		// RemoveRequiredBy) isn't part of pkg/tsuku's re-exposed surface,
+		// even though Remove itself is.
 		mgr := install.New(cfg)
 
 		// Get version before removal for telemetry
@@ -6,8 +6,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/tsuku-dev/tsuku/internal/config"
-	"github.com/tsuku-dev/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/pkg/tsuku"
 )
 
 var searchCmd = &cobra.Command{
@@ -33,15 +33,14 @@ var searchCmd = &cobra.Command{
 		var results []result
 
 		// Initialize install manager to check status
-		cfg, err := config.DefaultConfig()
+		mgr, err := tsuku.NewManager(tsuku.Options{})
 		if err != nil {
 			// If config fails, just assume nothing is installed
 			// This shouldn't really happen in practice
 			fmt.Fprintf(os.Stderr, "Warning: failed to load config: %v\n", err)
 		}
 		var installedTools []install.InstalledTool
-		if cfg != nil {
-			mgr := install.New(cfg)
+		if mgr != nil {
 			installedTools, _ = mgr.List() // Ignore error, just treat as empty
 		}
 
@@ -5,9 +5,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-	"github.com/tsuku-dev/tsuku/internal/config"
-	"github.com/tsuku-dev/tsuku/internal/install"
-	"github.com/tsuku-dev/tsuku/internal/telemetry"
+	"github.com/tsukumogami/tsuku/internal/telemetry"
+	"github.com/tsukumogami/tsuku/pkg/tsuku"
 )
 
 var updateDryRun bool
@@ -29,13 +28,12 @@ Examples:
 		telemetry.ShowNoticeIfNeeded()
 
 		// Check if installed
-		cfg, err := config.DefaultConfig()
+		mgr, err := tsuku.NewManager(tsuku.Options{})
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
 			os.Exit(1)
 		}
 
-		mgr := install.New(cfg)
 		tools, err := mgr.List()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to list tools: %v\n", err)
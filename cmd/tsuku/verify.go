@@ -168,6 +168,8 @@ var verifyCmd = &cobra.Command{
 			exitWithCode(ExitGeneral)
 		}
 
+		// Stays on install.New rather than pkg/tsuku.Manager: GetState isn't
+		// part of pkg/tsuku's re-exposed surface.
 		mgr := install.New(cfg)
 
 		// Check if tool is installed
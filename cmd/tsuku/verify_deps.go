@@ -9,11 +9,13 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/spf13/cobra"
 	"github.com/tsukumogami/tsuku/internal/actions"
 	"github.com/tsukumogami/tsuku/internal/executor"
 	"github.com/tsukumogami/tsuku/internal/platform"
 	"github.com/tsukumogami/tsuku/internal/recipe"
+	"github.com/tsukumogami/tsuku/internal/semverutil"
 )
 
 // CommandCheck represents the result of a require_command check.
@@ -23,6 +25,7 @@ type CommandCheck struct {
 	Path       string `json:"path,omitempty"`
 	Version    string `json:"version,omitempty"`
 	MinVersion string `json:"min_version,omitempty"`
+	Constraint string `json:"constraint,omitempty"` // semver constraint actually enforced
 	Error      string `json:"error,omitempty"`
 }
 
@@ -138,17 +141,34 @@ func verifyCommand(ctx context.Context, params map[string]interface{}) CommandCh
 	}
 	check.Path = path
 
-	// Check version if min_version is specified
+	// Check version if min_version or version_constraint is specified.
+	// min_version is sugar for the constraint ">=X"; version_constraint takes
+	// precedence and accepts full semver constraint expressions (">=1.20, <2",
+	// "~1.19", "^3.0 || ^4.0", etc.).
 	minVersion, hasMinVersion := actions.GetString(params, "min_version")
-	if hasMinVersion && minVersion != "" {
+	constraintExpr, hasConstraint := actions.GetString(params, "version_constraint")
+	if hasConstraint && constraintExpr != "" {
+		check.Constraint = constraintExpr
+	} else if hasMinVersion && minVersion != "" {
 		check.MinVersion = minVersion
+		constraintExpr = ">=" + minVersion
+		check.Constraint = constraintExpr
+	}
 
+	if constraintExpr != "" {
 		versionFlag, _ := actions.GetString(params, "version_flag")
 		versionRegex, _ := actions.GetString(params, "version_regex")
 
 		if versionFlag == "" || versionRegex == "" {
 			check.Status = "pass"
-			check.Error = "min_version specified but version_flag or version_regex missing"
+			check.Error = "version_constraint specified but version_flag or version_regex missing"
+			return check
+		}
+
+		constraint, err := semver.NewConstraint(constraintExpr)
+		if err != nil {
+			check.Status = "fail"
+			check.Error = fmt.Sprintf("invalid version_constraint %q: %v", constraintExpr, err)
 			return check
 		}
 
@@ -178,10 +198,17 @@ func verifyCommand(ctx context.Context, params map[string]interface{}) CommandCh
 
 		check.Version = strings.TrimSpace(matches[1])
 
-		// Compare versions
-		if !versionSatisfiesMinimum(check.Version, minVersion) {
+		detected, err := semverutil.CoerceVersion(check.Version)
+		if err != nil {
+			check.Status = "fail"
+			check.Error = fmt.Sprintf("could not parse detected version %q: %v", check.Version, err)
+			return check
+		}
+
+		// Compare against the constraint
+		if !constraint.Check(detected) {
 			check.Status = "version_mismatch"
-			check.Error = fmt.Sprintf("version %s does not meet minimum %s", check.Version, minVersion)
+			check.Error = fmt.Sprintf("version %s does not satisfy constraint %q", check.Version, constraintExpr)
 			return check
 		}
 	}
@@ -190,46 +217,6 @@ func verifyCommand(ctx context.Context, params map[string]interface{}) CommandCh
 	return check
 }
 
-// versionSatisfiesMinimum checks if detected version meets the minimum requirement.
-// Uses simple numeric comparison of version parts.
-func versionSatisfiesMinimum(detected, minimum string) bool {
-	// Strip common prefixes
-	detected = strings.TrimPrefix(detected, "v")
-	minimum = strings.TrimPrefix(minimum, "v")
-
-	detectedParts := strings.Split(detected, ".")
-	minimumParts := strings.Split(minimum, ".")
-
-	for i := 0; i < len(minimumParts); i++ {
-		if i >= len(detectedParts) {
-			return false
-		}
-
-		var detNum, minNum int
-		if _, err := fmt.Sscanf(detectedParts[i], "%d", &detNum); err == nil {
-			if _, err := fmt.Sscanf(minimumParts[i], "%d", &minNum); err == nil {
-				if detNum < minNum {
-					return false
-				}
-				if detNum > minNum {
-					return true
-				}
-				continue
-			}
-		}
-
-		// Fall back to string comparison
-		if detectedParts[i] < minimumParts[i] {
-			return false
-		}
-		if detectedParts[i] > minimumParts[i] {
-			return true
-		}
-	}
-
-	return true
-}
-
 // printVerifyDepsJSON outputs results in JSON format.
 func printVerifyDepsJSON(output VerifyDepsOutput) {
 	encoder := json.NewEncoder(os.Stdout)
@@ -110,37 +110,62 @@ func TestVerifyCommand_VersionMismatch(t *testing.T) {
 	}
 }
 
-func TestVersionSatisfiesMinimum(t *testing.T) {
+func TestVerifyCommand_VersionConstraint(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name     string
-		detected string
-		minimum  string
-		want     bool
-	}{
-		{"equal versions", "1.2.3", "1.2.3", true},
-		{"higher major", "2.0.0", "1.0.0", true},
-		{"higher minor", "1.3.0", "1.2.0", true},
-		{"higher patch", "1.2.4", "1.2.3", true},
-		{"lower major", "1.0.0", "2.0.0", false},
-		{"lower minor", "1.1.0", "1.2.0", false},
-		{"lower patch", "1.2.2", "1.2.3", false},
-		{"with v prefix detected", "v1.2.3", "1.2.3", true},
-		{"with v prefix minimum", "1.2.3", "v1.2.3", true},
-		{"both v prefix", "v1.2.3", "v1.2.3", true},
-		{"fewer parts in detected", "1.2", "1.2.0", false},
-		{"fewer parts in minimum", "1.2.3", "1.2", true},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			got := versionSatisfiesMinimum(tt.detected, tt.minimum)
-			if got != tt.want {
-				t.Errorf("versionSatisfiesMinimum(%q, %q) = %v, want %v", tt.detected, tt.minimum, got, tt.want)
-			}
-		})
+	// version_constraint should take precedence and accept full constraint
+	// expressions, not just a single minimum.
+	params := map[string]interface{}{
+		"command":            "bash",
+		"version_flag":       "--version",
+		"version_regex":      `([0-9]+\.[0-9]+)`,
+		"version_constraint": ">=1.0, <999",
+	}
+
+	check := verifyCommand(context.Background(), params)
+
+	if check.Status != "pass" {
+		t.Errorf("verifyCommand(version_constraint) status = %q, want %q; error: %s", check.Status, "pass", check.Error)
+	}
+	if check.Constraint != ">=1.0, <999" {
+		t.Errorf("verifyCommand(version_constraint) constraint = %q, want %q", check.Constraint, ">=1.0, <999")
+	}
+}
+
+func TestVerifyCommand_VersionConstraint_Mismatch(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]interface{}{
+		"command":            "bash",
+		"version_flag":       "--version",
+		"version_regex":      `([0-9]+\.[0-9]+)`,
+		"version_constraint": "<1.0",
+	}
+
+	check := verifyCommand(context.Background(), params)
+
+	if check.Status != "version_mismatch" {
+		t.Errorf("verifyCommand(unsatisfiable constraint) status = %q, want %q", check.Status, "version_mismatch")
+	}
+}
+
+func TestVerifyCommand_MinVersionCompilesToConstraint(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]interface{}{
+		"command":       "bash",
+		"version_flag":  "--version",
+		"version_regex": `([0-9]+\.[0-9]+)`,
+		"min_version":   "1.0",
+	}
+
+	check := verifyCommand(context.Background(), params)
+
+	if check.Constraint != ">=1.0" {
+		t.Errorf("verifyCommand(min_version) constraint = %q, want %q", check.Constraint, ">=1.0")
+	}
+	if check.MinVersion != "1.0" {
+		t.Errorf("verifyCommand(min_version) min_version = %q, want %q", check.MinVersion, "1.0")
 	}
 }
 
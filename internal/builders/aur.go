@@ -0,0 +1,291 @@
+package builders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/tsukumogami/tsuku/internal/recipe"
+)
+
+const (
+	// maxAURResponseSize limits response body to prevent memory exhaustion (10MB)
+	maxAURResponseSize = 10 * 1024 * 1024
+)
+
+// aurRPCResponse represents the AUR RPC v5 response envelope for both
+// type=info and type=search requests.
+type aurRPCResponse struct {
+	ResultCount int          `json:"resultcount"`
+	Results     []aurPackage `json:"results"`
+	Type        string       `json:"type"`
+}
+
+// aurPackage represents a single package entry from the AUR RPC.
+type aurPackage struct {
+	Name           string  `json:"Name"`
+	Version        string  `json:"Version"`
+	Description    string  `json:"Description"`
+	URL            string  `json:"URL"`
+	URLPath        string  `json:"URLPath"`
+	NumVotes       int     `json:"NumVotes"`
+	Popularity     float64 `json:"Popularity"`
+	FirstSubmitted int64   `json:"FirstSubmitted"` // unix timestamp
+	LastModified   int64   `json:"LastModified"`   // unix timestamp
+}
+
+// Pre-compile regex for AUR package name validation.
+// AUR names: lowercase letters, digits, and + - . @ _ (pacman package naming rules).
+var aurNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9+._@-]*$`)
+
+// knownGitHosts are source-forge hosts that indicate a linked upstream repository.
+var knownGitHosts = []string{"github.com", "gitlab.com", "bitbucket.org", "sr.ht", "codeberg.org"}
+
+// AURBuilder generates recipes for Arch User Repository (AUR) packages.
+// It queries the AUR RPC interface and generates a build-from-source recipe
+// that clones the package's PKGBUILD and runs makepkg.
+type AURBuilder struct {
+	httpClient *http.Client
+	aurBaseURL string
+}
+
+// NewAURBuilder creates a new AURBuilder with the given HTTP client.
+// If httpClient is nil, a default client with timeouts will be created.
+func NewAURBuilder(httpClient *http.Client) *AURBuilder {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 60 * time.Second,
+		}
+	}
+	return &AURBuilder{
+		httpClient: httpClient,
+		aurBaseURL: "https://aur.archlinux.org",
+	}
+}
+
+// NewAURBuilderWithBaseURL creates a new AURBuilder with a custom AUR URL (for testing).
+func NewAURBuilderWithBaseURL(httpClient *http.Client, baseURL string) *AURBuilder {
+	b := NewAURBuilder(httpClient)
+	b.aurBaseURL = baseURL
+	return b
+}
+
+// Name returns the builder identifier.
+func (b *AURBuilder) Name() string {
+	return "aur"
+}
+
+// RequiresLLM returns false as this builder uses the AUR RPC API, not LLM.
+func (b *AURBuilder) RequiresLLM() bool {
+	return false
+}
+
+// CanBuild checks if the package exists in the AUR.
+func (b *AURBuilder) CanBuild(ctx context.Context, req BuildRequest) (bool, error) {
+	if !isValidAURName(req.Package) {
+		return false, nil
+	}
+
+	pkg, err := b.fetchPackageInfo(ctx, req.Package)
+	if err != nil {
+		return false, err
+	}
+	return pkg != nil, nil
+}
+
+// NewSession creates a new build session for the given request.
+func (b *AURBuilder) NewSession(ctx context.Context, req BuildRequest, opts *SessionOptions) (BuildSession, error) {
+	return NewDeterministicSession(b.Build, req), nil
+}
+
+// Build generates a recipe for the AUR package.
+//
+// The recipe clones the package's git repository (every AUR package is
+// backed by one) and runs makepkg to build and install the PKGBUILD. This
+// is a skeleton: makepkg's own dependency resolution and prompts mean the
+// generated recipe will often need manual follow-up, but it's enough for
+// recipe generation to proceed.
+func (b *AURBuilder) Build(ctx context.Context, req BuildRequest) (*BuildResult, error) {
+	if !isValidAURName(req.Package) {
+		return nil, fmt.Errorf("invalid AUR package name: %s", req.Package)
+	}
+
+	pkg, err := b.fetchPackageInfo(ctx, req.Package)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch package info: %w", err)
+	}
+	if pkg == nil {
+		return nil, fmt.Errorf("package %s not found in AUR", req.Package)
+	}
+
+	result := &BuildResult{
+		Source:   fmt.Sprintf("aur:%s", pkg.Name),
+		Warnings: []string{"AUR recipes run makepkg directly; review the PKGBUILD before trusting this recipe"},
+	}
+
+	r := &recipe.Recipe{
+		Metadata: recipe.MetadataSection{
+			Name:         pkg.Name,
+			Description:  pkg.Description,
+			Homepage:     pkg.URL,
+			RequiresSudo: true,
+		},
+		Version: recipe.VersionSection{
+			Source: fmt.Sprintf("aur:%s", pkg.Name),
+		},
+		Steps: []recipe.Step{
+			{
+				Action:      "run_command",
+				Description: fmt.Sprintf("Clone %s from the AUR", pkg.Name),
+				Params: map[string]interface{}{
+					"command": fmt.Sprintf("git clone --depth 1 %s/%s.git", b.aurBaseURL, pkg.Name),
+				},
+			},
+			{
+				Action:      "run_command",
+				Description: "Build and install the PKGBUILD",
+				Params: map[string]interface{}{
+					"command":       "makepkg -si --noconfirm",
+					"working_dir":   pkg.Name,
+					"requires_sudo": true,
+				},
+			},
+		},
+		Verify: recipe.VerifySection{
+			Command: fmt.Sprintf("%s --version", pkg.Name),
+		},
+	}
+
+	result.Recipe = r
+	return result, nil
+}
+
+// Probe checks if a package exists in the AUR, falling back to a search
+// query when the exact-name info lookup misses (e.g. AUR RPC quirks around
+// recently-renamed packages).
+func (b *AURBuilder) Probe(ctx context.Context, name string) (*ProbeResult, error) {
+	if !isValidAURName(name) {
+		return nil, nil
+	}
+
+	pkg, err := b.fetchPackageInfo(ctx, name)
+	if err != nil {
+		return nil, nil
+	}
+	if pkg == nil {
+		pkg, err = b.searchExactMatch(ctx, name)
+		if err != nil || pkg == nil {
+			return nil, nil
+		}
+	}
+
+	// ProbeResult only carries the signals the ecosystem probe stage ranks
+	// on (see disambiguate.go); NumVotes is the closest AUR analogue to a
+	// download count, and HasRepository follows from a linked known git host.
+	return &ProbeResult{
+		Source:        pkg.Name,
+		Downloads:     pkg.NumVotes,
+		HasRepository: isKnownGitHost(pkg.URL),
+	}, nil
+}
+
+// fetchPackageInfo queries the AUR RPC type=info endpoint for an exact package name.
+func (b *AURBuilder) fetchPackageInfo(ctx context.Context, name string) (*aurPackage, error) {
+	results, err := b.queryRPC(ctx, "info", name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		if results[i].Name == name {
+			return &results[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// searchExactMatch queries the AUR RPC type=search endpoint and returns the
+// result whose name exactly matches (search matches substrings and
+// descriptions, so this still needs to filter down to an exact hit).
+func (b *AURBuilder) searchExactMatch(ctx context.Context, name string) (*aurPackage, error) {
+	results, err := b.queryRPC(ctx, "search", name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		if results[i].Name == name {
+			return &results[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// queryRPC performs an AUR RPC v5 request of the given type and returns the results.
+func (b *AURBuilder) queryRPC(ctx context.Context, queryType, arg string) ([]aurPackage, error) {
+	rpcURL, err := url.Parse(b.aurBaseURL + "/rpc/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid AUR base URL: %w", err)
+	}
+	q := rpcURL.Query()
+	q.Set("v", "5")
+	q.Set("type", queryType)
+	q.Set("arg", arg)
+	rpcURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", rpcURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "tsuku/1.0 (https://github.com/tsukumogami/tsuku)")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query AUR RPC: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 429 {
+		return nil, fmt.Errorf("aur.archlinux.org rate limit exceeded")
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("aur.archlinux.org returned status %d", resp.StatusCode)
+	}
+
+	limitedReader := io.LimitReader(resp.Body, maxAURResponseSize)
+	var parsed aurRPCResponse
+	if err := json.NewDecoder(limitedReader).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse AUR RPC response: %w", err)
+	}
+
+	return parsed.Results, nil
+}
+
+// isValidAURName validates AUR package names per pacman naming rules:
+// lowercase letters, digits, and the characters + - . @ _, not starting
+// with a hyphen or dot.
+func isValidAURName(name string) bool {
+	if name == "" || len(name) > 128 {
+		return false
+	}
+	return aurNameRegex.MatchString(name)
+}
+
+// isKnownGitHost reports whether the given URL points at a well-known git
+// forge, used as a proxy for "has a linked source repository".
+func isKnownGitHost(rawURL string) bool {
+	if rawURL == "" {
+		return false
+	}
+	for _, host := range knownGitHosts {
+		if strings.Contains(rawURL, host) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+package builders
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAURBuilder_Name(t *testing.T) {
+	builder := NewAURBuilder(nil)
+	if builder.Name() != "aur" {
+		t.Errorf("Name() = %q, want %q", builder.Name(), "aur")
+	}
+}
+
+func TestAURBuilder_CanBuild_Found(t *testing.T) {
+	response := `{
+		"resultcount": 1,
+		"results": [{"Name": "yay", "Version": "12.3.5-1", "Description": "Yet another yogurt", "URL": "https://github.com/Jguer/yay", "NumVotes": 1234}]
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") == "info" && r.URL.Query().Get("arg") == "yay" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(response))
+		} else {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"resultcount": 0, "results": []}`))
+		}
+	}))
+	defer server.Close()
+
+	builder := NewAURBuilderWithBaseURL(nil, server.URL)
+	ctx := context.Background()
+
+	canBuild, err := builder.CanBuild(ctx, BuildRequest{Package: "yay"})
+	if err != nil {
+		t.Fatalf("CanBuild() error = %v", err)
+	}
+	if !canBuild {
+		t.Error("CanBuild() = false, want true")
+	}
+}
+
+func TestAURBuilder_CanBuild_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resultcount": 0, "results": []}`))
+	}))
+	defer server.Close()
+
+	builder := NewAURBuilderWithBaseURL(nil, server.URL)
+	ctx := context.Background()
+
+	canBuild, err := builder.CanBuild(ctx, BuildRequest{Package: "doesnotexist"})
+	if err != nil {
+		t.Fatalf("CanBuild() error = %v", err)
+	}
+	if canBuild {
+		t.Error("CanBuild() = true, want false")
+	}
+}
+
+func TestAURBuilder_Probe_PopulatesMetadataFromNumVotes(t *testing.T) {
+	response := `{
+		"resultcount": 1,
+		"results": [{"Name": "yay", "Version": "12.3.5-1", "URL": "https://github.com/Jguer/yay", "NumVotes": 1234}]
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	builder := NewAURBuilderWithBaseURL(nil, server.URL)
+	ctx := context.Background()
+
+	result, err := builder.Probe(ctx, "yay")
+	if err != nil {
+		t.Fatalf("Probe() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("Probe() = nil, want a result")
+	}
+	if result.Downloads != 1234 {
+		t.Errorf("Downloads = %d, want 1234", result.Downloads)
+	}
+	if !result.HasRepository {
+		t.Error("HasRepository = false, want true for a github.com URL")
+	}
+}
+
+func TestAURBuilder_Probe_FallsBackToSearch(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("type") == "info" {
+			_, _ = w.Write([]byte(`{"resultcount": 0, "results": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"resultcount": 1, "results": [{"Name": "yay-bin", "NumVotes": 42}]}`))
+	}))
+	defer server.Close()
+
+	builder := NewAURBuilderWithBaseURL(nil, server.URL)
+	ctx := context.Background()
+
+	result, err := builder.Probe(ctx, "yay-bin")
+	if err != nil {
+		t.Fatalf("Probe() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("Probe() = nil, want a result from the search fallback")
+	}
+	if calls < 2 {
+		t.Errorf("expected both info and search queries, got %d calls", calls)
+	}
+}
+
+func TestAURBuilder_Probe_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resultcount": 0, "results": []}`))
+	}))
+	defer server.Close()
+
+	builder := NewAURBuilderWithBaseURL(nil, server.URL)
+	ctx := context.Background()
+
+	result, err := builder.Probe(ctx, "doesnotexist")
+	if err != nil {
+		t.Fatalf("Probe() error = %v", err)
+	}
+	if result != nil {
+		t.Errorf("Probe() = %+v, want nil", result)
+	}
+}
+
+func TestIsValidAURName(t *testing.T) {
+	valid := []string{"yay", "yay-bin", "python-pip", "a.b+c@1"}
+	for _, name := range valid {
+		if !isValidAURName(name) {
+			t.Errorf("isValidAURName(%q) = false, want true", name)
+		}
+	}
+
+	invalid := []string{"", "-leading-hyphen", "Uppercase", "has space"}
+	for _, name := range invalid {
+		if isValidAURName(name) {
+			t.Errorf("isValidAURName(%q) = true, want false", name)
+		}
+	}
+}
+
+func TestIsKnownGitHost(t *testing.T) {
+	if !isKnownGitHost("https://github.com/Jguer/yay") {
+		t.Error("isKnownGitHost() = false for github.com URL, want true")
+	}
+	if isKnownGitHost("https://example.com/yay") {
+		t.Error("isKnownGitHost() = true for unrelated URL, want false")
+	}
+	if isKnownGitHost("") {
+		t.Error("isKnownGitHost() = true for empty URL, want false")
+	}
+}
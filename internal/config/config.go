@@ -52,13 +52,18 @@ func GetAPITimeout() time.Duration {
 
 // Config holds tsuku configuration
 type Config struct {
-	HomeDir     string // ~/.tsuku
-	ToolsDir    string // ~/.tsuku/tools
-	CurrentDir  string // ~/.tsuku/tools/current
-	RecipesDir  string // ~/.tsuku/recipes
-	RegistryDir string // ~/.tsuku/registry (cached recipes from remote registry)
-	LibsDir     string // ~/.tsuku/libs (shared libraries)
-	ConfigFile  string // ~/.tsuku/config.toml
+	HomeDir        string // ~/.tsuku
+	ToolsDir       string // ~/.tsuku/tools
+	CurrentDir     string // ~/.tsuku/tools/current
+	RecipesDir     string // ~/.tsuku/recipes
+	RegistryDir    string // ~/.tsuku/registry (cached recipes from remote registry)
+	LibsDir        string // ~/.tsuku/libs (shared libraries)
+	PluginsDir     string // ~/.tsuku/plugins (external action plugins)
+	ConfigFile     string // ~/.tsuku/config.toml
+	TrustFile      string // ~/.tsuku/trust.toml (signer trust policy for installation plans)
+	SourcesDir     string // ~/.tsuku/cache/sources (discover.SourceManager's on-disk version/manifest cache)
+	NotifySpoolDir string // ~/.tsuku/spool/notify (undelivered notify.Dispatcher events)
+	OSVCacheDir    string // ~/.tsuku/cache/osv (discover.OSVProbe's on-disk query cache)
 }
 
 // DefaultConfig returns the default configuration
@@ -74,13 +79,18 @@ func DefaultConfig() (*Config, error) {
 	}
 
 	return &Config{
-		HomeDir:     tsukuHome,
-		ToolsDir:    filepath.Join(tsukuHome, "tools"),
-		CurrentDir:  filepath.Join(tsukuHome, "tools", "current"),
-		RecipesDir:  filepath.Join(tsukuHome, "recipes"),
-		RegistryDir: filepath.Join(tsukuHome, "registry"),
-		LibsDir:     filepath.Join(tsukuHome, "libs"),
-		ConfigFile:  filepath.Join(tsukuHome, "config.toml"),
+		HomeDir:        tsukuHome,
+		ToolsDir:       filepath.Join(tsukuHome, "tools"),
+		CurrentDir:     filepath.Join(tsukuHome, "tools", "current"),
+		RecipesDir:     filepath.Join(tsukuHome, "recipes"),
+		RegistryDir:    filepath.Join(tsukuHome, "registry"),
+		LibsDir:        filepath.Join(tsukuHome, "libs"),
+		PluginsDir:     filepath.Join(tsukuHome, "plugins"),
+		ConfigFile:     filepath.Join(tsukuHome, "config.toml"),
+		TrustFile:      filepath.Join(tsukuHome, "trust.toml"),
+		SourcesDir:     filepath.Join(tsukuHome, "cache", "sources"),
+		NotifySpoolDir: filepath.Join(tsukuHome, "spool", "notify"),
+		OSVCacheDir:    filepath.Join(tsukuHome, "cache", "osv"),
 	}, nil
 }
 
@@ -93,6 +103,7 @@ func (c *Config) EnsureDirectories() error {
 		c.RecipesDir,
 		c.RegistryDir,
 		c.LibsDir,
+		c.PluginsDir,
 	}
 
 	for _, dir := range dirs {
@@ -19,6 +19,8 @@ type EcosystemProbe struct {
 	filter                *QualityFilter
 	confirmDisambiguation ConfirmDisambiguationFunc // optional callback for interactive mode
 	forceDeterministic    bool                      // select deterministically even without clear winner
+	osvProbe              *OSVProbe                 // optional vulnerability signal, queried post-disambiguation
+	allowVulnerable       bool                      // proceed even if osvProbe finds HIGH/CRITICAL advisories
 }
 
 // EcosystemProbeOption configures an EcosystemProbe.
@@ -43,6 +45,54 @@ func WithForceDeterministic() EcosystemProbeOption {
 	}
 }
 
+// WithOSVProbe attaches a vulnerability signal lookup. Once disambiguation
+// selects a single winning source, the probe is queried and any findings are
+// attached to the result, since OSV queries are scoped to one (ecosystem,
+// name) pair at a time. Skipped entirely in forced-deterministic (batch)
+// mode, since that mode favors speed over a live network lookup.
+func WithOSVProbe(probe *OSVProbe) EcosystemProbeOption {
+	return func(p *EcosystemProbe) {
+		p.osvProbe = probe
+	}
+}
+
+// WithAllowVulnerable disables the HIGH/CRITICAL hard stop, allowing
+// Resolve to return a vulnerable source with Vulnerabilities populated
+// instead of a VulnerableSourceWarning.
+func WithAllowVulnerable() EcosystemProbeOption {
+	return func(p *EcosystemProbe) {
+		p.allowVulnerable = true
+	}
+}
+
+// checkVulnerabilities queries the OSV probe (if configured) for the
+// selected result and attaches any findings. It returns a
+// VulnerableSourceWarning if the highest severity found is HIGH or
+// CRITICAL and allowVulnerable wasn't set; that warning is a hard stop for
+// the caller, distinct from the soft errors OSV lookups otherwise produce.
+// Skipped when forceDeterministic is set (batch mode) or osvProbe is nil.
+func (p *EcosystemProbe) checkVulnerabilities(ctx context.Context, toolName string, result *DiscoveryResult) error {
+	if p.osvProbe == nil || p.forceDeterministic || result == nil {
+		return nil
+	}
+	vulns, err := p.osvProbe.Check(ctx, result.Builder, result.Source)
+	if err != nil {
+		// Soft failure: proceed without vulnerability data.
+		return nil
+	}
+	result.Vulnerabilities = vulns
+
+	if !p.allowVulnerable && HighestSeverity(vulns) >= SeverityHigh {
+		return &VulnerableSourceWarning{
+			Tool:            toolName,
+			Builder:         result.Builder,
+			Source:          result.Source,
+			Vulnerabilities: vulns,
+		}
+	}
+	return nil
+}
+
 // NewEcosystemProbe creates a resolver that queries ecosystem builders in parallel.
 // The timeout applies to all probers collectively via a shared context.
 func NewEcosystemProbe(probers []builders.EcosystemProber, timeout time.Duration, opts ...EcosystemProbeOption) *EcosystemProbe {
@@ -58,6 +108,7 @@ func NewEcosystemProbe(probers []builders.EcosystemProber, timeout time.Duration
 			"rubygems":  6,
 			"go":        7,
 			"cpan":      8,
+			"aur":       9,
 		},
 		filter: NewQualityFilter(),
 	}
@@ -149,7 +200,14 @@ func (p *EcosystemProbe) Resolve(ctx context.Context, toolName string) (*Discove
 	}
 
 	// Disambiguate: rank by popularity and check for clear winner.
-	return disambiguate(toolName, matches, p.priority, p.confirmDisambiguation, p.forceDeterministic)
+	selected, err := disambiguate(toolName, matches, p.priority, p.confirmDisambiguation, p.forceDeterministic)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkVulnerabilities(ctx, toolName, selected); err != nil {
+		return nil, err
+	}
+	return selected, nil
 }
 
 // ProbeOutcome holds the result from a single builder's Probe() call.
@@ -205,6 +263,9 @@ func (p *EcosystemProbe) ResolveWithDetails(ctx context.Context, toolName string
 	if err != nil {
 		return nil, err
 	}
+	if err := p.checkVulnerabilities(ctx, toolName, selected); err != nil {
+		return nil, err
+	}
 
 	return &ResolveResult{
 		Selected:  selected,
@@ -2,8 +2,11 @@ package discover
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -94,6 +97,103 @@ func TestEcosystemProbe_MultipleResults_PriorityRanking(t *testing.T) {
 	}
 }
 
+func TestEcosystemProbe_OSVAttachesVulnerabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(osvQueryResponse{
+			Vulns: []osvVuln{{ID: "GHSA-low-0001", DatabaseSpecific: osvDatabaseSpecific{Severity: "LOW"}}},
+		})
+	}))
+	defer server.Close()
+
+	osvProbe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL))
+	probe := NewEcosystemProbe([]builders.EcosystemProber{
+		&mockProber{name: "pypi", result: &builders.ProbeResult{Source: "flask", Downloads: 1000}},
+	}, 5*time.Second, WithOSVProbe(osvProbe))
+
+	result, err := probe.Resolve(context.Background(), "flask")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Vulnerabilities) != 1 || result.Vulnerabilities[0].ID != "GHSA-low-0001" {
+		t.Errorf("expected LOW vuln attached, got %+v", result.Vulnerabilities)
+	}
+}
+
+func TestEcosystemProbe_OSVHardStopsOnHighSeverity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(osvQueryResponse{
+			Vulns: []osvVuln{{ID: "GHSA-high-0001", DatabaseSpecific: osvDatabaseSpecific{Severity: "CRITICAL"}}},
+		})
+	}))
+	defer server.Close()
+
+	osvProbe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL))
+	probe := NewEcosystemProbe([]builders.EcosystemProber{
+		&mockProber{name: "pypi", result: &builders.ProbeResult{Source: "flask", Downloads: 1000}},
+	}, 5*time.Second, WithOSVProbe(osvProbe))
+
+	result, err := probe.Resolve(context.Background(), "flask")
+	if result != nil {
+		t.Errorf("expected nil result on vulnerable hard stop, got %+v", result)
+	}
+	var vulnErr *VulnerableSourceWarning
+	if !errors.As(err, &vulnErr) {
+		t.Fatalf("expected *VulnerableSourceWarning, got %v", err)
+	}
+	if vulnErr.Tool != "flask" || vulnErr.Builder != "pypi" {
+		t.Errorf("unexpected warning fields: %+v", vulnErr)
+	}
+}
+
+func TestEcosystemProbe_OSVAllowVulnerableBypassesHardStop(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(osvQueryResponse{
+			Vulns: []osvVuln{{ID: "GHSA-high-0001", DatabaseSpecific: osvDatabaseSpecific{Severity: "CRITICAL"}}},
+		})
+	}))
+	defer server.Close()
+
+	osvProbe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL))
+	probe := NewEcosystemProbe([]builders.EcosystemProber{
+		&mockProber{name: "pypi", result: &builders.ProbeResult{Source: "flask", Downloads: 1000}},
+	}, 5*time.Second, WithOSVProbe(osvProbe), WithAllowVulnerable())
+
+	result, err := probe.Resolve(context.Background(), "flask")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result == nil || len(result.Vulnerabilities) != 1 {
+		t.Fatalf("expected result with vulnerabilities attached, got %+v", result)
+	}
+}
+
+func TestEcosystemProbe_OSVSkippedInForceDeterministicMode(t *testing.T) {
+	var queried bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queried = true
+		json.NewEncoder(w).Encode(osvQueryResponse{
+			Vulns: []osvVuln{{ID: "GHSA-high-0001", DatabaseSpecific: osvDatabaseSpecific{Severity: "CRITICAL"}}},
+		})
+	}))
+	defer server.Close()
+
+	osvProbe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL))
+	probe := NewEcosystemProbe([]builders.EcosystemProber{
+		&mockProber{name: "pypi", result: &builders.ProbeResult{Source: "flask", Downloads: 1000}},
+	}, 5*time.Second, WithOSVProbe(osvProbe), WithForceDeterministic())
+
+	result, err := probe.Resolve(context.Background(), "flask")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result == nil {
+		t.Fatal("expected result, got nil")
+	}
+	if queried {
+		t.Error("expected OSV probe to be skipped in forced-deterministic mode")
+	}
+}
+
 func TestEcosystemProbe_NameMismatch(t *testing.T) {
 	probe := NewEcosystemProbe([]builders.EcosystemProber{
 		&mockProber{name: "npm", result: &builders.ProbeResult{Source: "other-tool"}},
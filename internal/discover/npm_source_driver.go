@@ -0,0 +1,124 @@
+package discover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/tsukumogami/tsuku/internal/httputil"
+)
+
+// npmPackageNameRegex mirrors the validation used by builders.NpmBuilder and
+// version.Resolver's npm path; this package can't import either without
+// creating a dependency it doesn't otherwise need.
+var npmPackageNameRegex = regexp.MustCompile(`^(@[a-z0-9][\w.-]*/)?[a-z0-9][\w.-]*$`)
+
+// NpmSourceDriver fetches version listings and manifests from the npm
+// registry (https://registry.npmjs.org), for use as a SourceManager driver
+// under the "npm" builder name.
+type NpmSourceDriver struct {
+	httpClient *http.Client
+	registry   string // base registry URL, overridable for testing
+}
+
+// NewNpmSourceDriver creates a SourceDriver backed by the public npm
+// registry.
+func NewNpmSourceDriver() *NpmSourceDriver {
+	return &NpmSourceDriver{
+		httpClient: httputil.NewSecureClient(httputil.DefaultOptions()),
+		registry:   "https://registry.npmjs.org",
+	}
+}
+
+type npmPackageDoc struct {
+	Versions map[string]struct {
+		Dist struct {
+			Tarball   string `json:"tarball"`
+			Shasum    string `json:"shasum"`
+			Integrity string `json:"integrity"`
+		} `json:"dist"`
+	} `json:"versions"`
+}
+
+// ListVersions returns every published version of an npm package, per the
+// "versions" map in the registry's package document.
+func (d *NpmSourceDriver) ListVersions(ctx context.Context, source string) ([]string, error) {
+	doc, err := d.fetchDoc(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(doc.Versions))
+	for v := range doc.Versions {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// GetManifest fetches the tarball URL and checksum for one published
+// version of an npm package. It prefers the registry's subresource
+// integrity string (sha512) and falls back to the legacy shasum (sha1)
+// when integrity is absent.
+func (d *NpmSourceDriver) GetManifest(ctx context.Context, source, version string) (Manifest, error) {
+	doc, err := d.fetchDoc(ctx, source)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	entry, ok := doc.Versions[version]
+	if !ok {
+		return Manifest{}, fmt.Errorf("npm: version %s not found for %s", version, source)
+	}
+
+	checksum := entry.Dist.Integrity
+	if checksum == "" && entry.Dist.Shasum != "" {
+		checksum = "sha1:" + entry.Dist.Shasum
+	}
+
+	return Manifest{
+		Version:     version,
+		DownloadURL: entry.Dist.Tarball,
+		Checksum:    checksum,
+	}, nil
+}
+
+// fetchDoc retrieves and parses the registry's package document for source.
+func (d *NpmSourceDriver) fetchDoc(ctx context.Context, source string) (*npmPackageDoc, error) {
+	if !npmPackageNameRegex.MatchString(source) {
+		return nil, fmt.Errorf("npm: invalid package name: %s", source)
+	}
+
+	reqURL := d.registry + "/" + source
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("npm: failed to create request: %w", err)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("npm: failed to fetch %s: %w", source, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("npm: package not found: %s", source)
+	default:
+		return nil, fmt.Errorf("npm: registry returned status %d for %s", resp.StatusCode, source)
+	}
+
+	const maxResponseSize = 50 * 1024 * 1024 // 50MB, matches version.ListNpmVersions
+	body := io.LimitReader(resp.Body, maxResponseSize)
+
+	var doc npmPackageDoc
+	if err := json.NewDecoder(body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("npm: failed to parse response for %s: %w", source, err)
+	}
+	return &doc, nil
+}
@@ -0,0 +1,91 @@
+package discover
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestNpmDriver(t *testing.T, handler http.HandlerFunc) *NpmSourceDriver {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	driver := NewNpmSourceDriver()
+	driver.registry = server.URL
+	return driver
+}
+
+const leftPadDoc = `{
+	"versions": {
+		"1.0.0": {"dist": {"tarball": "https://registry.npmjs.org/left-pad/-/left-pad-1.0.0.tgz", "shasum": "abc123"}},
+		"1.3.0": {"dist": {"tarball": "https://registry.npmjs.org/left-pad/-/left-pad-1.3.0.tgz", "integrity": "sha512-deadbeef"}}
+	}
+}`
+
+func TestNpmSourceDriver_ListVersions(t *testing.T) {
+	driver := newTestNpmDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/left-pad" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(leftPadDoc))
+	})
+
+	versions, err := driver.ListVersions(context.Background(), "left-pad")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("expected 2 versions, got %v", versions)
+	}
+}
+
+func TestNpmSourceDriver_GetManifest(t *testing.T) {
+	driver := newTestNpmDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(leftPadDoc))
+	})
+
+	m, err := driver.GetManifest(context.Background(), "left-pad", "1.3.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Checksum != "sha512-deadbeef" {
+		t.Errorf("expected integrity checksum, got %q", m.Checksum)
+	}
+
+	m, err = driver.GetManifest(context.Background(), "left-pad", "1.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Checksum != "sha1:abc123" {
+		t.Errorf("expected shasum fallback, got %q", m.Checksum)
+	}
+}
+
+func TestNpmSourceDriver_GetManifest_VersionNotFound(t *testing.T) {
+	driver := newTestNpmDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(leftPadDoc))
+	})
+
+	if _, err := driver.GetManifest(context.Background(), "left-pad", "9.9.9"); err == nil {
+		t.Fatal("expected error for unknown version")
+	}
+}
+
+func TestNpmSourceDriver_PackageNotFound(t *testing.T) {
+	driver := newTestNpmDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := driver.ListVersions(context.Background(), "left-pad"); err == nil {
+		t.Fatal("expected error for 404")
+	}
+}
+
+func TestNpmSourceDriver_InvalidPackageName(t *testing.T) {
+	driver := NewNpmSourceDriver()
+	if _, err := driver.ListVersions(context.Background(), "../../etc/passwd"); err == nil {
+		t.Fatal("expected error for invalid package name")
+	}
+}
@@ -0,0 +1,333 @@
+package discover
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/tsukumogami/tsuku/internal/httputil"
+)
+
+const (
+	// osvAPIURL is the OSV.dev query endpoint.
+	osvAPIURL = "https://api.osv.dev/v1/query"
+
+	// maxOSVResponseSize limits response body to prevent memory exhaustion (10MB).
+	maxOSVResponseSize = 10 * 1024 * 1024
+
+	// defaultOSVCacheTTL controls how long a cached OSV query result is valid.
+	defaultOSVCacheTTL = 24 * time.Hour
+)
+
+// osvEcosystemByBuilder maps internal builder names to OSV ecosystem identifiers.
+// Builders with no entry are not tracked by OSV and are skipped by the probe.
+var osvEcosystemByBuilder = map[string]string{
+	"crates.io": "crates.io",
+	"pypi":      "PyPI",
+	"npm":       "npm",
+	"rubygems":  "RubyGems",
+	"go":        "Go",
+}
+
+// builderToOSVEcosystem translates a builder name to its OSV ecosystem
+// identifier. Returns false if OSV does not track that ecosystem.
+func builderToOSVEcosystem(builder string) (string, bool) {
+	ecosystem, ok := osvEcosystemByBuilder[builder]
+	return ecosystem, ok
+}
+
+// osvQueryRequest is the request body for OSV's query endpoint, scoped to a
+// package name within an ecosystem (no version, since at discovery time the
+// resolved version isn't known yet; this returns all known vulnerabilities
+// for the package).
+type osvQueryRequest struct {
+	Package osvPackage `json:"package"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+// osvQueryResponse is the relevant subset of OSV's query response.
+type osvQueryResponse struct {
+	Vulns []osvVuln `json:"vulns"`
+}
+
+type osvVuln struct {
+	ID               string              `json:"id"`
+	Summary          string              `json:"summary"`
+	Affected         []osvAffected       `json:"affected"`
+	DatabaseSpecific osvDatabaseSpecific `json:"database_specific"`
+}
+
+// osvDatabaseSpecific carries the source-specific severity label. OSV
+// aggregates advisories from GHSA, PyPA, RustSec, etc.; most of them
+// populate this human-readable severity even when they don't provide a
+// machine-parseable CVSS vector.
+type osvDatabaseSpecific struct {
+	Severity string `json:"severity"`
+}
+
+type osvAffected struct {
+	Ranges []osvRange `json:"ranges"`
+}
+
+type osvRange struct {
+	Events []osvEvent `json:"events"`
+}
+
+type osvEvent struct {
+	Fixed string `json:"fixed"`
+}
+
+// osvCacheEntry mirrors version.cacheEntry's disk-cache-with-TTL shape.
+type osvCacheEntry struct {
+	Vulns     []Vulnerability `json:"vulns"`
+	CachedAt  time.Time       `json:"cached_at"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// OSVProbe queries the OSV (Open Source Vulnerabilities) database for known
+// advisories against a resolved ecosystem package. It is invoked once per
+// tool name, after disambiguation has picked a single winning source, since
+// OSV queries are scoped to a single (ecosystem, name) pair.
+type OSVProbe struct {
+	httpClient *http.Client
+	apiURL     string // OSV query endpoint (injectable for testing)
+	cacheDir   string
+	ttl        time.Duration
+	maxQueries int
+
+	mu      sync.Mutex
+	queries int
+}
+
+// OSVProbeOption configures an OSVProbe.
+type OSVProbeOption func(*OSVProbe)
+
+// WithOSVCacheTTL overrides the default 24h cache TTL.
+func WithOSVCacheTTL(ttl time.Duration) OSVProbeOption {
+	return func(p *OSVProbe) {
+		p.ttl = ttl
+	}
+}
+
+// WithOSVMaxQueries caps the number of live OSV queries an OSVProbe instance
+// will issue over its lifetime. Cache hits don't count against the budget.
+// Zero (the default) means unlimited.
+func WithOSVMaxQueries(max int) OSVProbeOption {
+	return func(p *OSVProbe) {
+		p.maxQueries = max
+	}
+}
+
+// withOSVAPIURL overrides the OSV query endpoint. Unexported: only used by tests.
+func withOSVAPIURL(url string) OSVProbeOption {
+	return func(p *OSVProbe) {
+		p.apiURL = url
+	}
+}
+
+// NewOSVProbe creates a probe that queries OSV, caching results on disk under
+// cacheDir (typically $TSUKU_HOME/cache/osv).
+func NewOSVProbe(cacheDir string, opts ...OSVProbeOption) *OSVProbe {
+	p := &OSVProbe{
+		httpClient: httputil.NewSecureClient(httputil.DefaultOptions()),
+		apiURL:     osvAPIURL, // Production default
+		cacheDir:   cacheDir,
+		ttl:        defaultOSVCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// errOSVBudgetExceeded is returned (and swallowed by callers as a soft miss)
+// once an OSVProbe has issued its configured maximum number of live queries.
+var errOSVBudgetExceeded = fmt.Errorf("osv: query budget exhausted")
+
+// Check looks up known vulnerabilities for a resolved (builder, name) pair.
+// Returns (nil, nil) if the builder's ecosystem isn't tracked by OSV, or if
+// no advisories were found. Errors are soft: callers should log and proceed
+// as if no vulnerability data was available.
+func (p *OSVProbe) Check(ctx context.Context, builder, name string) ([]Vulnerability, error) {
+	ecosystem, ok := builderToOSVEcosystem(builder)
+	if !ok {
+		return nil, nil
+	}
+
+	cacheFile := p.cacheFilePath(ecosystem, name)
+	if entry, err := p.readCache(cacheFile); err == nil {
+		if time.Now().Before(entry.ExpiresAt) {
+			return entry.Vulns, nil
+		}
+	}
+
+	if err := p.reserveQuery(); err != nil {
+		return nil, err
+	}
+
+	vulns, err := p.query(ctx, ecosystem, name)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = p.writeCache(cacheFile, vulns)
+	return vulns, nil
+}
+
+// reserveQuery increments the query counter, returning errOSVBudgetExceeded
+// once maxQueries has been reached. A zero maxQueries means unlimited.
+func (p *OSVProbe) reserveQuery() error {
+	if p.maxQueries == 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.queries >= p.maxQueries {
+		return errOSVBudgetExceeded
+	}
+	p.queries++
+	return nil
+}
+
+// query issues a live request against the OSV API.
+func (p *OSVProbe) query(ctx context.Context, ecosystem, name string) ([]Vulnerability, error) {
+	reqBody, err := json.Marshal(osvQueryRequest{
+		Package: osvPackage{Name: name, Ecosystem: ecosystem},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("osv: failed to marshal query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("osv: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "tsuku-package-manager")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("osv: query failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("osv: query returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxOSVResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("osv: failed to read response: %w", err)
+	}
+
+	var parsed osvQueryResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("osv: failed to parse response: %w", err)
+	}
+
+	vulns := make([]Vulnerability, len(parsed.Vulns))
+	for i, v := range parsed.Vulns {
+		vulns[i] = toVulnerability(v)
+	}
+	return vulns, nil
+}
+
+// toVulnerability converts an OSV API vuln entry to our normalized type.
+func toVulnerability(v osvVuln) Vulnerability {
+	var fixed []string
+	for _, affected := range v.Affected {
+		for _, r := range affected.Ranges {
+			for _, event := range r.Events {
+				if event.Fixed != "" {
+					fixed = append(fixed, event.Fixed)
+				}
+			}
+		}
+	}
+
+	return Vulnerability{
+		ID:            v.ID,
+		Severity:      parseOSVSeverity(v.DatabaseSpecific.Severity),
+		Summary:       v.Summary,
+		FixedVersions: fixed,
+	}
+}
+
+// parseOSVSeverity maps OSV's database_specific.severity label to our ranked
+// Severity type. Returns SeverityUnknown for an empty or unrecognized label
+// rather than guessing.
+func parseOSVSeverity(label string) Severity {
+	switch label {
+	case "LOW":
+		return SeverityLow
+	case "MODERATE":
+		return SeverityModerate
+	case "HIGH":
+		return SeverityHigh
+	case "CRITICAL":
+		return SeverityCritical
+	default:
+		return SeverityUnknown
+	}
+}
+
+// cacheFilePath returns the path to the cache file for an (ecosystem, name) pair.
+func (p *OSVProbe) cacheFilePath(ecosystem, name string) string {
+	hash := sha256.Sum256([]byte(ecosystem + ":" + name))
+	filename := hex.EncodeToString(hash[:8]) + ".json"
+	return filepath.Join(p.cacheDir, filename)
+}
+
+// readCache reads and parses a cache file.
+func (p *OSVProbe) readCache(path string) (*osvCacheEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry osvCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// writeCache atomically writes a cache entry to disk.
+func (p *OSVProbe) writeCache(path string, vulns []Vulnerability) error {
+	if err := os.MkdirAll(p.cacheDir, 0755); err != nil {
+		return fmt.Errorf("osv: failed to create cache directory: %w", err)
+	}
+
+	entry := osvCacheEntry{
+		Vulns:     vulns,
+		CachedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(p.ttl),
+	}
+
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("osv: failed to marshal cache entry: %w", err)
+	}
+
+	tempFile := path + ".tmp"
+	if err := os.WriteFile(tempFile, data, 0644); err != nil {
+		return fmt.Errorf("osv: failed to write temp cache file: %w", err)
+	}
+	if err := os.Rename(tempFile, path); err != nil {
+		os.Remove(tempFile)
+		return fmt.Errorf("osv: failed to rename cache file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package discover
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBuilderToOSVEcosystem(t *testing.T) {
+	tests := []struct {
+		builder   string
+		wantEco   string
+		wantFound bool
+	}{
+		{"npm", "npm", true},
+		{"pypi", "PyPI", true},
+		{"crates.io", "crates.io", true},
+		{"rubygems", "RubyGems", true},
+		{"go", "Go", true},
+		{"homebrew", "", false},
+		{"cask", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.builder, func(t *testing.T) {
+			eco, ok := builderToOSVEcosystem(tt.builder)
+			if ok != tt.wantFound {
+				t.Fatalf("found = %v, want %v", ok, tt.wantFound)
+			}
+			if eco != tt.wantEco {
+				t.Errorf("ecosystem = %q, want %q", eco, tt.wantEco)
+			}
+		})
+	}
+}
+
+func TestParseOSVSeverity(t *testing.T) {
+	tests := []struct {
+		label string
+		want  Severity
+	}{
+		{"LOW", SeverityLow},
+		{"MODERATE", SeverityModerate},
+		{"HIGH", SeverityHigh},
+		{"CRITICAL", SeverityCritical},
+		{"", SeverityUnknown},
+		{"WEIRD", SeverityUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			if got := parseOSVSeverity(tt.label); got != tt.want {
+				t.Errorf("parseOSVSeverity(%q) = %v, want %v", tt.label, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOSVProbe_Check_UntrackedEcosystem(t *testing.T) {
+	probe := NewOSVProbe(t.TempDir())
+
+	vulns, err := probe.Check(context.Background(), "homebrew", "bat")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vulns != nil {
+		t.Errorf("expected nil vulns for untracked ecosystem, got %+v", vulns)
+	}
+}
+
+func TestOSVProbe_Check_QueryAndCache(t *testing.T) {
+	var queries int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries++
+		var req osvQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Package.Ecosystem != "npm" || req.Package.Name != "left-pad" {
+			t.Errorf("unexpected query: %+v", req.Package)
+		}
+		json.NewEncoder(w).Encode(osvQueryResponse{
+			Vulns: []osvVuln{
+				{
+					ID:               "GHSA-test-0001",
+					Summary:          "test advisory",
+					DatabaseSpecific: osvDatabaseSpecific{Severity: "HIGH"},
+					Affected: []osvAffected{
+						{Ranges: []osvRange{{Events: []osvEvent{{Fixed: "1.3.0"}}}}},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	probe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL))
+
+	vulns, err := probe.Check(context.Background(), "npm", "left-pad")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("expected 1 vuln, got %d", len(vulns))
+	}
+	if vulns[0].ID != "GHSA-test-0001" || vulns[0].Severity != SeverityHigh {
+		t.Errorf("unexpected vuln: %+v", vulns[0])
+	}
+	if len(vulns[0].FixedVersions) != 1 || vulns[0].FixedVersions[0] != "1.3.0" {
+		t.Errorf("unexpected fixed versions: %+v", vulns[0].FixedVersions)
+	}
+
+	// Second call should be served from cache, not issue another query.
+	if _, err := probe.Check(context.Background(), "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error on second call: %v", err)
+	}
+	if queries != 1 {
+		t.Errorf("expected 1 live query (cache hit on second call), got %d", queries)
+	}
+}
+
+func TestOSVProbe_Check_CacheExpired(t *testing.T) {
+	var queries int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries++
+		json.NewEncoder(w).Encode(osvQueryResponse{})
+	}))
+	defer server.Close()
+
+	probe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL), WithOSVCacheTTL(1*time.Millisecond))
+
+	if _, err := probe.Check(context.Background(), "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if _, err := probe.Check(context.Background(), "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if queries != 2 {
+		t.Errorf("expected 2 live queries after cache expiry, got %d", queries)
+	}
+}
+
+func TestOSVProbe_Check_BudgetExceeded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(osvQueryResponse{})
+	}))
+	defer server.Close()
+
+	probe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL), WithOSVMaxQueries(1))
+
+	if _, err := probe.Check(context.Background(), "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error on first query: %v", err)
+	}
+	if _, err := probe.Check(context.Background(), "npm", "is-even"); err != errOSVBudgetExceeded {
+		t.Errorf("expected errOSVBudgetExceeded on second distinct query, got %v", err)
+	}
+}
+
+func TestOSVProbe_Check_QueryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	probe := NewOSVProbe(t.TempDir(), withOSVAPIURL(server.URL))
+
+	if _, err := probe.Check(context.Background(), "npm", "left-pad"); err == nil {
+		t.Error("expected error from a failing OSV query")
+	}
+}
+
+func TestHighestSeverity(t *testing.T) {
+	if got := HighestSeverity(nil); got != SeverityUnknown {
+		t.Errorf("HighestSeverity(nil) = %v, want SeverityUnknown", got)
+	}
+
+	vulns := []Vulnerability{
+		{Severity: SeverityLow},
+		{Severity: SeverityCritical},
+		{Severity: SeverityModerate},
+	}
+	if got := HighestSeverity(vulns); got != SeverityCritical {
+		t.Errorf("HighestSeverity() = %v, want SeverityCritical", got)
+	}
+}
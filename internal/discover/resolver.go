@@ -2,6 +2,7 @@ package discover
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -57,14 +58,88 @@ type DiscoveryResult struct {
 	// LLMMetrics holds cost and usage metrics from LLM discovery.
 	// Only set for results from the LLM discovery stage.
 	LLMMetrics *LLMMetrics
+
+	// Vulnerabilities lists known security advisories affecting the resolved
+	// source, if any were found. Populated by an optional OSV probe after
+	// disambiguation; empty when the probe is not configured or found nothing.
+	Vulnerabilities []Vulnerability
+}
+
+// Vulnerability describes a known security advisory for a discovered source,
+// as reported by the OSV (Open Source Vulnerabilities) database.
+type Vulnerability struct {
+	ID            string   // OSV identifier (e.g. "GHSA-xxxx-xxxx-xxxx")
+	Severity      Severity // Normalized severity rank
+	Summary       string   // One-line human-readable description
+	FixedVersions []string // Versions known to contain a fix, if any
+}
+
+// Severity ranks vulnerability severity for threshold comparisons.
+// Values increase with severity so they can be compared with <, >=, etc.
+type Severity int
+
+const (
+	SeverityUnknown Severity = iota
+	SeverityLow
+	SeverityModerate
+	SeverityHigh
+	SeverityCritical
+)
+
+// String returns the display name for a severity level.
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "LOW"
+	case SeverityModerate:
+		return "MODERATE"
+	case SeverityHigh:
+		return "HIGH"
+	case SeverityCritical:
+		return "CRITICAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// HighestSeverity returns the highest severity found across vulns, or
+// SeverityUnknown if vulns is empty.
+func HighestSeverity(vulns []Vulnerability) Severity {
+	highest := SeverityUnknown
+	for _, v := range vulns {
+		if v.Severity > highest {
+			highest = v.Severity
+		}
+	}
+	return highest
+}
+
+// VulnerableSourceWarning indicates the resolved source has known HIGH or
+// CRITICAL severity vulnerabilities and --allow-vulnerable was not set.
+// It is a hard stop: the resolver chain does not try further stages.
+type VulnerableSourceWarning struct {
+	Tool            string
+	Builder         string
+	Source          string
+	Vulnerabilities []Vulnerability
+}
+
+func (e *VulnerableSourceWarning) Error() string {
+	return fmt.Sprintf("'%s' (%s/%s) has %s severity vulnerabilities: use --allow-vulnerable to proceed anyway",
+		e.Tool, e.Builder, e.Source, HighestSeverity(e.Vulnerabilities))
+}
+
+func (e *VulnerableSourceWarning) Suggestion() string {
+	return "Pass --allow-vulnerable to install anyway, or wait for a patched version."
 }
 
 // LLMMetrics contains cost and usage metrics from an LLM discovery session.
 type LLMMetrics struct {
 	InputTokens  int     // Total input tokens used
 	OutputTokens int     // Total output tokens used
-	Cost         float64 // Estimated cost in USD
+	Cost         float64 // Estimated cost in USD, from llm.Usage.Cost() for Provider/Model
 	Provider     string  // LLM provider name (e.g., "claude", "gemini")
+	Model        string  // Model identifier used, for cross-provider cost accounting
 	Turns        int     // Number of LLM conversation turns
 }
 
@@ -173,9 +248,9 @@ func (e *AmbiguousMatchError) Error() string {
 }
 
 // isFatalError returns true for errors that should stop the resolver chain.
-// Context cancellation and budget exhaustion are fatal; everything else is soft.
+// Context cancellation, budget exhaustion, and confirmed vulnerable sources
+// are fatal; everything else is soft.
 func isFatalError(err error) bool {
-	// For now, only context errors are fatal. Budget/rate-limit errors will
-	// be added when the LLM discovery stage is implemented.
-	return false
+	var vulnErr *VulnerableSourceWarning
+	return errors.As(err, &vulnErr)
 }
@@ -0,0 +1,330 @@
+package discover
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/tsukumogami/tsuku/internal/log"
+)
+
+// defaultSourceManagerTTL controls how long a cached version list or
+// manifest is valid before a SourceManager re-fetches it.
+const defaultSourceManagerTTL = 6 * time.Hour
+
+// Manifest describes the fetched metadata for one resolved version of a
+// source, as returned by a SourceDriver's GetManifest.
+type Manifest struct {
+	Version     string // The resolved version string
+	DownloadURL string // Where the artifact can be downloaded from
+	Checksum    string // "algo:hex", e.g. "sha256:abc123" (empty if unavailable)
+}
+
+// SourceDriver fetches version listings and manifests for a single
+// ecosystem. Each driver is registered with a SourceManager under its
+// builder name (e.g. "crates.io", "npm", "pypi", "github_releases", "aur").
+type SourceDriver interface {
+	// ListVersions returns all known versions for source, newest-first
+	// ordering is not required; callers sort as needed.
+	ListVersions(ctx context.Context, source string) ([]string, error)
+
+	// GetManifest fetches download/checksum metadata for a single
+	// resolved version of source.
+	GetManifest(ctx context.Context, source, version string) (Manifest, error)
+}
+
+// SourceManagerConfig configures a SourceManager.
+type SourceManagerConfig struct {
+	// CacheDir is the on-disk cache directory, typically
+	// ~/.cache/tsuku/sources.
+	CacheDir string
+
+	// TTL controls how long a cached version list or manifest is valid.
+	// Zero uses defaultSourceManagerTTL.
+	TTL time.Duration
+
+	// Refresh bypasses cache reads (but still writes fresh results back to
+	// the cache), matching the --refresh CLI flag.
+	Refresh bool
+
+	// Logger receives debug/info messages about cache hits and fetches.
+	// Optional; nil disables logging.
+	Logger log.Logger
+}
+
+// SourceManager is a cached front-end to per-ecosystem SourceDrivers,
+// modeled on the SourceManager abstraction in golang/dep's gps: resolvers
+// consult it instead of reaching out to ecosystem APIs ad hoc, and it
+// serializes concurrent fetches of the same source with on-disk lock files
+// so that multiple tsuku invocations don't race each other.
+type SourceManager struct {
+	cacheDir string
+	ttl      time.Duration
+	refresh  bool
+	logger   log.Logger
+	drivers  map[string]SourceDriver
+}
+
+// NewSourceManager creates a SourceManager backed by cfg.CacheDir, with one
+// SourceDriver per builder name.
+func NewSourceManager(cfg SourceManagerConfig, drivers map[string]SourceDriver) *SourceManager {
+	ttl := cfg.TTL
+	if ttl == 0 {
+		ttl = defaultSourceManagerTTL
+	}
+	return &SourceManager{
+		cacheDir: cfg.CacheDir,
+		ttl:      ttl,
+		refresh:  cfg.Refresh,
+		logger:   cfg.Logger,
+		drivers:  drivers,
+	}
+}
+
+// NewDefaultSourceManager creates a SourceManager with the built-in
+// SourceDrivers registered (currently just "npm"; other ecosystems still
+// rely on their ad-hoc resolvers until drivers are written for them).
+func NewDefaultSourceManager(cfg SourceManagerConfig) *SourceManager {
+	return NewSourceManager(cfg, map[string]SourceDriver{
+		"npm": NewNpmSourceDriver(),
+	})
+}
+
+// Release relinquishes any resources held by the SourceManager. Locks in
+// this implementation are scoped to a single ListVersions/GetManifest call
+// rather than held for the manager's lifetime, so there is nothing to
+// release today; Release exists so callers can treat SourceManager the same
+// way regardless of how future drivers manage long-lived connections.
+func (sm *SourceManager) Release() error {
+	return nil
+}
+
+// versionsCacheEntry is the on-disk shape for a cached ListVersions result.
+type versionsCacheEntry struct {
+	Versions  []string  `json:"versions"`
+	CachedAt  time.Time `json:"cached_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// manifestCacheEntry is the on-disk shape for a cached GetManifest result.
+type manifestCacheEntry struct {
+	Manifest  Manifest  `json:"manifest"`
+	CachedAt  time.Time `json:"cached_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ListVersions returns all known versions for (builder, source), serving
+// from cache when fresh. Concurrent calls for the same (builder, source),
+// whether from goroutines in this process or other tsuku invocations, are
+// serialized by a per-source lock file so the driver is only queried once.
+func (sm *SourceManager) ListVersions(ctx context.Context, builder, source string) ([]string, error) {
+	driver, err := sm.driverFor(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "versions:" + builder + ":" + source
+	cacheFile := sm.cacheFilePath(key)
+
+	if !sm.refresh {
+		if entry, ok := sm.readVersionsCache(cacheFile); ok {
+			sm.logDebug("cache hit for %s/%s versions", builder, source)
+			return entry.Versions, nil
+		}
+	}
+
+	unlock, err := sm.lock(key)
+	if err != nil {
+		return nil, fmt.Errorf("source manager: failed to lock %s/%s: %w", builder, source, err)
+	}
+	defer unlock()
+
+	// Re-check the cache now that we hold the lock: another process may
+	// have populated it while we were waiting.
+	if !sm.refresh {
+		if entry, ok := sm.readVersionsCache(cacheFile); ok {
+			sm.logDebug("cache hit for %s/%s versions (after lock)", builder, source)
+			return entry.Versions, nil
+		}
+	}
+
+	sm.logDebug("fetching %s/%s versions", builder, source)
+	versions, err := driver.ListVersions(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = sm.writeVersionsCache(cacheFile, versions)
+	return versions, nil
+}
+
+// GetManifest fetches download/checksum metadata for a single resolved
+// version of (builder, source), serving from cache when fresh. Locking
+// mirrors ListVersions.
+func (sm *SourceManager) GetManifest(ctx context.Context, builder, source, version string) (Manifest, error) {
+	driver, err := sm.driverFor(builder)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	key := "manifest:" + builder + ":" + source + ":" + version
+	cacheFile := sm.cacheFilePath(key)
+
+	if !sm.refresh {
+		if entry, ok := sm.readManifestCache(cacheFile); ok {
+			sm.logDebug("cache hit for %s/%s@%s manifest", builder, source, version)
+			return entry.Manifest, nil
+		}
+	}
+
+	unlock, err := sm.lock(key)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("source manager: failed to lock %s/%s@%s: %w", builder, source, version, err)
+	}
+	defer unlock()
+
+	if !sm.refresh {
+		if entry, ok := sm.readManifestCache(cacheFile); ok {
+			sm.logDebug("cache hit for %s/%s@%s manifest (after lock)", builder, source, version)
+			return entry.Manifest, nil
+		}
+	}
+
+	sm.logDebug("fetching %s/%s@%s manifest", builder, source, version)
+	manifest, err := driver.GetManifest(ctx, source, version)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	_ = sm.writeManifestCache(cacheFile, manifest)
+	return manifest, nil
+}
+
+// driverFor looks up the registered SourceDriver for builder.
+func (sm *SourceManager) driverFor(builder string) (SourceDriver, error) {
+	driver, ok := sm.drivers[builder]
+	if !ok {
+		return nil, fmt.Errorf("source manager: no driver registered for builder %q", builder)
+	}
+	return driver, nil
+}
+
+// lock acquires an exclusive, blocking file lock for key, serializing
+// concurrent fetches across goroutines and processes. The returned func
+// releases the lock; callers must call it exactly once.
+func (sm *SourceManager) lock(key string) (func(), error) {
+	if err := os.MkdirAll(sm.cacheDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	lockPath := sm.cacheFilePath(key) + ".lock"
+	file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+
+	return func() {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		file.Close()
+	}, nil
+}
+
+// cacheFilePath returns the path to the cache file for a cache key.
+func (sm *SourceManager) cacheFilePath(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	filename := hex.EncodeToString(hash[:8]) + ".json"
+	return filepath.Join(sm.cacheDir, filename)
+}
+
+func (sm *SourceManager) readVersionsCache(path string) (*versionsCacheEntry, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry versionsCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (sm *SourceManager) writeVersionsCache(path string, versions []string) error {
+	if err := os.MkdirAll(sm.cacheDir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	entry := versionsCacheEntry{
+		Versions:  versions,
+		CachedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(sm.ttl),
+	}
+	return writeJSONAtomic(path, entry)
+}
+
+func (sm *SourceManager) readManifestCache(path string) (*manifestCacheEntry, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry manifestCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (sm *SourceManager) writeManifestCache(path string, manifest Manifest) error {
+	if err := os.MkdirAll(sm.cacheDir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	entry := manifestCacheEntry{
+		Manifest:  manifest,
+		CachedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(sm.ttl),
+	}
+	return writeJSONAtomic(path, entry)
+}
+
+// writeJSONAtomic marshals v to JSON and writes it to path via a temp file
+// plus rename, so readers never observe a partial write.
+func writeJSONAtomic(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+
+	tempFile := path + ".tmp"
+	if err := os.WriteFile(tempFile, data, 0644); err != nil {
+		return fmt.Errorf("failed to write temp cache file: %w", err)
+	}
+	if err := os.Rename(tempFile, path); err != nil {
+		os.Remove(tempFile)
+		return fmt.Errorf("failed to rename cache file: %w", err)
+	}
+	return nil
+}
+
+// logDebug logs a debug message if a logger is configured.
+func (sm *SourceManager) logDebug(format string, args ...any) {
+	if sm.logger != nil {
+		sm.logger.Debug(fmt.Sprintf(format, args...))
+	}
+}
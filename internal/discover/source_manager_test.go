@@ -0,0 +1,152 @@
+package discover
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a test double for SourceDriver that counts calls and can
+// simulate a slow fetch, to exercise the SourceManager's locking behavior.
+type fakeDriver struct {
+	versionCalls  int32
+	manifestCalls int32
+	delay         time.Duration
+	versions      []string
+	manifest      Manifest
+	err           error
+}
+
+func (d *fakeDriver) ListVersions(ctx context.Context, source string) ([]string, error) {
+	atomic.AddInt32(&d.versionCalls, 1)
+	if d.delay > 0 {
+		time.Sleep(d.delay)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.versions, nil
+}
+
+func (d *fakeDriver) GetManifest(ctx context.Context, source, version string) (Manifest, error) {
+	atomic.AddInt32(&d.manifestCalls, 1)
+	if d.delay > 0 {
+		time.Sleep(d.delay)
+	}
+	if d.err != nil {
+		return Manifest{}, d.err
+	}
+	return d.manifest, nil
+}
+
+func TestSourceManager_ListVersions_CacheHit(t *testing.T) {
+	driver := &fakeDriver{versions: []string{"1.0.0", "1.1.0"}}
+	sm := NewSourceManager(SourceManagerConfig{CacheDir: t.TempDir()}, map[string]SourceDriver{"npm": driver})
+
+	ctx := context.Background()
+	if _, err := sm.ListVersions(ctx, "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	versions, err := sm.ListVersions(ctx, "npm", "left-pad")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Errorf("expected 2 versions, got %d", len(versions))
+	}
+	if driver.versionCalls != 1 {
+		t.Errorf("expected 1 driver call (second served from cache), got %d", driver.versionCalls)
+	}
+}
+
+func TestSourceManager_ListVersions_UnknownBuilder(t *testing.T) {
+	sm := NewSourceManager(SourceManagerConfig{CacheDir: t.TempDir()}, map[string]SourceDriver{})
+
+	if _, err := sm.ListVersions(context.Background(), "npm", "left-pad"); err == nil {
+		t.Error("expected error for unregistered builder")
+	}
+}
+
+func TestSourceManager_ListVersions_Refresh(t *testing.T) {
+	driver := &fakeDriver{versions: []string{"1.0.0"}}
+	sm := NewSourceManager(SourceManagerConfig{CacheDir: t.TempDir(), Refresh: true}, map[string]SourceDriver{"npm": driver})
+
+	ctx := context.Background()
+	if _, err := sm.ListVersions(ctx, "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := sm.ListVersions(ctx, "npm", "left-pad"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if driver.versionCalls != 2 {
+		t.Errorf("expected 2 driver calls with Refresh set, got %d", driver.versionCalls)
+	}
+}
+
+func TestSourceManager_GetManifest_CacheHit(t *testing.T) {
+	driver := &fakeDriver{manifest: Manifest{Version: "1.0.0", Checksum: "sha256:abc123"}}
+	sm := NewSourceManager(SourceManagerConfig{CacheDir: t.TempDir()}, map[string]SourceDriver{"npm": driver})
+
+	ctx := context.Background()
+	if _, err := sm.GetManifest(ctx, "npm", "left-pad", "1.0.0"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	manifest, err := sm.GetManifest(ctx, "npm", "left-pad", "1.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manifest.Checksum != "sha256:abc123" {
+		t.Errorf("unexpected checksum: %s", manifest.Checksum)
+	}
+	if driver.manifestCalls != 1 {
+		t.Errorf("expected 1 driver call (second served from cache), got %d", driver.manifestCalls)
+	}
+}
+
+// TestSourceManager_ListVersions_ConcurrentRace spawns two goroutines
+// fetching the same source at once. The lock file should serialize them so
+// the second goroutine observes the first's cached result instead of
+// issuing its own driver call.
+func TestSourceManager_ListVersions_ConcurrentRace(t *testing.T) {
+	driver := &fakeDriver{
+		versions: []string{"1.0.0"},
+		delay:    50 * time.Millisecond,
+	}
+	sm := NewSourceManager(SourceManagerConfig{CacheDir: t.TempDir()}, map[string]SourceDriver{"npm": driver})
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	results := make([][]string, 2)
+	errs := make([]error, 2)
+
+	wg.Add(2)
+	for i := 0; i < 2; i++ {
+		go func(idx int) {
+			defer wg.Done()
+			results[idx], errs[idx] = sm.ListVersions(ctx, "npm", "left-pad")
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("goroutine %d: unexpected error: %v", i, err)
+		}
+		if len(results[i]) != 1 || results[i][0] != "1.0.0" {
+			t.Errorf("goroutine %d: unexpected result: %v", i, results[i])
+		}
+	}
+
+	if driver.versionCalls != 1 {
+		t.Errorf("expected exactly 1 driver call for racing goroutines, got %d", driver.versionCalls)
+	}
+}
+
+func TestSourceManager_Release(t *testing.T) {
+	sm := NewSourceManager(SourceManagerConfig{CacheDir: t.TempDir()}, nil)
+	if err := sm.Release(); err != nil {
+		t.Errorf("Release() error = %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// PlannedOp describes a single operation that a dry run would have performed.
+// DryRunExecutor accumulates one of these per step instead of mutating the
+// filesystem or the network, so callers can render a plan's effects (via
+// --explain or --print-plan) without actually applying them.
+type PlannedOp struct {
+	// Step is the 1-indexed position of this step among all flattened steps
+	// (including dependency steps), matching the numbering ExecutePlan prints.
+	Step int `json:"step"`
+
+	// Action is the action name (e.g. "download", "extract", "run_command").
+	Action string `json:"action"`
+
+	// Skipped is true when the step would not run against the current host.
+	Skipped bool `json:"skipped"`
+
+	// Reason explains why a step is skipped, or summarizes what it would do
+	// when it is not skipped.
+	Reason string `json:"reason"`
+
+	// URL and Checksum mirror ResolvedStep for download steps, so --explain
+	// output can show exactly what would be fetched and verified.
+	URL      string `json:"url,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// DryRunExecutor records the operations an InstallationPlan would perform
+// without executing any of them. It routes plan steps through the same
+// validation ExecutePlan uses, so a dry run fails for the same reasons a real
+// run would (missing checksums, unknown actions, unsupported format version).
+type DryRunExecutor struct {
+	ops []PlannedOp
+}
+
+// NewDryRunExecutor creates a DryRunExecutor ready to plan a single run.
+func NewDryRunExecutor() *DryRunExecutor {
+	return &DryRunExecutor{}
+}
+
+// Plan validates plan and records the operations it would perform, in the
+// same flattened dependency-first order ExecutePlan executes them in. It
+// never touches the filesystem or network. The returned PlannedOp slice is
+// also retained on the DryRunExecutor for later inspection via Ops().
+func (d *DryRunExecutor) Plan(plan *InstallationPlan) ([]PlannedOp, error) {
+	if err := ValidatePlan(plan); err != nil {
+		return nil, fmt.Errorf("plan validation failed: %w", err)
+	}
+
+	// Per-step WhenClause filtering already happened at plan-generation time:
+	// GeneratePlan drops non-matching steps before they ever reach
+	// plan.Steps, so a resolved step carries no When condition to re-check
+	// here. The only skip this dry run can detect is a whole-plan platform
+	// mismatch, i.e. the plan was generated for an OS/Arch other than the one
+	// running it now.
+	skipped, skipReason := planTargetsOtherHost(plan)
+
+	allSteps := flattenPlanSteps(plan)
+	ops := make([]PlannedOp, 0, len(allSteps))
+	for i, step := range allSteps {
+		reason := describePlannedStep(step)
+		if skipped {
+			reason = skipReason
+		}
+		ops = append(ops, PlannedOp{
+			Step:     i + 1,
+			Action:   step.Action,
+			Skipped:  skipped,
+			Reason:   reason,
+			URL:      step.URL,
+			Checksum: step.Checksum.String(),
+		})
+	}
+
+	d.ops = ops
+	return ops, nil
+}
+
+// planTargetsOtherHost reports whether plan was generated for a platform
+// other than the one currently running it, and if so, the reason to display
+// for each of its (otherwise unexecutable) steps.
+func planTargetsOtherHost(plan *InstallationPlan) (bool, string) {
+	if plan.Platform.OS != "" && plan.Platform.OS != runtime.GOOS {
+		return true, fmt.Sprintf("skipped: plan targets os=%s, running on %s", plan.Platform.OS, runtime.GOOS)
+	}
+	if plan.Platform.Arch != "" && plan.Platform.Arch != runtime.GOARCH {
+		return true, fmt.Sprintf("skipped: plan targets arch=%s, running on %s", plan.Platform.Arch, runtime.GOARCH)
+	}
+	return false, ""
+}
+
+// Ops returns the operations recorded by the most recent call to Plan.
+func (d *DryRunExecutor) Ops() []PlannedOp {
+	return d.ops
+}
+
+// describePlannedStep renders a one-line, human-readable summary of what a
+// resolved step would do. Unlike formatActionDescription, it works directly
+// from a ResolvedStep's already-expanded params, since plan steps carry no
+// unresolved template variables.
+func describePlannedStep(step ResolvedStep) string {
+	switch step.Action {
+	case "download":
+		return fmt.Sprintf("download %s", step.URL)
+	case "extract":
+		if src, ok := step.Params["src"].(string); ok {
+			return fmt.Sprintf("extract %s", src)
+		}
+	case "install_binaries":
+		return "install resolved binaries"
+	case "install_libraries":
+		return "install resolved libraries"
+	case "chmod":
+		if file, ok := step.Params["file"].(string); ok {
+			return fmt.Sprintf("chmod %s", file)
+		}
+	case "set_env":
+		if key, ok := step.Params["key"].(string); ok {
+			return fmt.Sprintf("set env %s", key)
+		}
+	case "set_rpath":
+		return "patch rpath"
+	case "link_dependencies":
+		return "link resolved dependencies"
+	case "validate_checksum":
+		return "validate checksum"
+	case "run_command":
+		if cmd, ok := step.Params["command"].(string); ok {
+			return fmt.Sprintf("run %s", cmd)
+		}
+	}
+	return step.Action
+}
@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"runtime"
+	"testing"
+)
+
+func samePlatformPlan() *InstallationPlan {
+	return &InstallationPlan{
+		FormatVersion: PlanFormatVersion,
+		Tool:          "ripgrep",
+		Version:       "14.1.0",
+		Platform:      Platform{OS: runtime.GOOS, Arch: runtime.GOARCH},
+		Steps: []ResolvedStep{
+			{Action: "run_command", Params: map[string]interface{}{"command": "true"}},
+		},
+	}
+}
+
+func TestDryRunExecutor_Plan_RunsOnMatchingPlatform(t *testing.T) {
+	d := NewDryRunExecutor()
+	ops, err := d.Plan(samePlatformPlan())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("expected 1 op, got %d", len(ops))
+	}
+	if ops[0].Skipped {
+		t.Error("expected step not to be skipped on a matching platform")
+	}
+}
+
+func TestDryRunExecutor_Plan_SkipsOnOSMismatch(t *testing.T) {
+	plan := samePlatformPlan()
+	plan.Platform.OS = "plan9" // no real host runs this
+
+	d := NewDryRunExecutor()
+	ops, err := d.Plan(plan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ops[0].Skipped {
+		t.Error("expected step to be skipped when plan.Platform.OS doesn't match the running host")
+	}
+	if ops[0].Reason == "" {
+		t.Error("expected a reason explaining the skip")
+	}
+}
+
+func TestDryRunExecutor_Plan_SkipsOnArchMismatch(t *testing.T) {
+	plan := samePlatformPlan()
+	plan.Platform.Arch = "mips" // no real host runs this
+
+	d := NewDryRunExecutor()
+	ops, err := d.Plan(plan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ops[0].Skipped {
+		t.Error("expected step to be skipped when plan.Platform.Arch doesn't match the running host")
+	}
+}
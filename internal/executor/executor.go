@@ -2,7 +2,6 @@ package executor
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -10,9 +9,12 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/tsukumogami/tsuku/internal/actions"
 	"github.com/tsukumogami/tsuku/internal/log"
+	"github.com/tsukumogami/tsuku/internal/notify"
+	"github.com/tsukumogami/tsuku/internal/plansign"
 	"github.com/tsukumogami/tsuku/internal/recipe"
 	"github.com/tsukumogami/tsuku/internal/version"
 )
@@ -24,10 +26,15 @@ type Executor struct {
 	downloadCacheDir string // Download cache directory
 	recipe           *recipe.Recipe
 	ctx              *actions.ExecutionContext
-	version          string   // Resolved version
-	reqVersion       string   // Requested version (optional)
-	execPaths        []string // Additional bin paths for execution (e.g., nodejs for npm tools)
-	toolsDir         string   // Tools directory (~/.tsuku/tools/) for finding other installed tools
+	version          string             // Resolved version
+	reqVersion       string             // Requested version (optional)
+	execPaths        []string           // Additional bin paths for execution (e.g., nodejs for npm tools)
+	toolsDir         string             // Tools directory (~/.tsuku/tools/) for finding other installed tools
+	notifier         *notify.Dispatcher // Optional event notifier; nil means no notifications are emitted
+
+	requireSignedPlans bool                  // When true, ExecutePlan refuses any plan without a verified signature
+	trustPolicy        *plansign.TrustPolicy // Pins which signer identities are trusted per recipe source
+	planSignature      *plansign.Signature   // Detached signature for the plan passed to ExecutePlan, if any
 }
 
 // New creates a new executor
@@ -166,6 +173,36 @@ func (e *Executor) SetToolsDir(dir string) {
 	e.toolsDir = dir
 }
 
+// SetNotifier configures a notify.Dispatcher to receive StepStarted,
+// StepCompleted, StepFailed, and PlanCompleted events as ExecutePlan runs.
+// Callers are responsible for calling Dispatcher.Start/Stop; a nil notifier
+// (the default) disables event emission entirely.
+func (e *Executor) SetNotifier(notifier *notify.Dispatcher) {
+	e.notifier = notifier
+}
+
+// SetRequireSignedPlans controls whether ExecutePlan refuses to run a plan
+// that has no verified signature, regardless of what the trust policy says
+// about its recipe source. This is the enforcement knob for
+// userconfig.Config.RequireSignedPlans.
+func (e *Executor) SetRequireSignedPlans(require bool) {
+	e.requireSignedPlans = require
+}
+
+// SetTrustPolicy configures which signer identities ExecutePlan trusts for
+// each recipe source. A nil policy (the default) trusts no one and requires
+// no signatures on its own.
+func (e *Executor) SetTrustPolicy(trust *plansign.TrustPolicy) {
+	e.trustPolicy = trust
+}
+
+// SetPlanSignature attaches the detached signature that accompanies the plan
+// passed to the next ExecutePlan call, typically loaded from a plan file's
+// .sig sidecar via plansign.ReadSignatureFile.
+func (e *Executor) SetPlanSignature(sig *plansign.Signature) {
+	e.planSignature = sig
+}
+
 // expandVars replaces {var} placeholders in a string
 func expandVars(s string, vars map[string]string) string {
 	result := s
@@ -274,6 +311,25 @@ func formatActionDescription(action string, params map[string]interface{}, vars
 	return ""
 }
 
+// verifyPlanSignature enforces signed-plan policy before execution. It is a
+// no-op when neither requireSignedPlans nor the trust policy demands a
+// signature for plan.RecipeSource; otherwise it requires planSignature to be
+// present and to verify against trustPolicy.
+func (e *Executor) verifyPlanSignature(plan *InstallationPlan) error {
+	mustVerify := e.requireSignedPlans || e.trustPolicy.RequiresSignature(plan.RecipeSource)
+	if !mustVerify {
+		return nil
+	}
+
+	identity, err := VerifyPlan(plan, e.planSignature, e.trustPolicy)
+	if err != nil {
+		return fmt.Errorf("refusing to execute unsigned or unverified plan: %w", err)
+	}
+
+	log.Default().Info("plan signature verified", "identity", identity, "tool", plan.Tool)
+	return nil
+}
+
 // ExecutePlan executes an installation plan, verifying checksums for download steps.
 // All downloads are verified against the checksums recorded in the plan.
 // Returns ChecksumMismatchError if a download's checksum doesn't match the plan.
@@ -284,6 +340,10 @@ func (e *Executor) ExecutePlan(ctx context.Context, plan *InstallationPlan) erro
 		return fmt.Errorf("plan validation failed: %w", err)
 	}
 
+	if err := e.verifyPlanSignature(plan); err != nil {
+		return err
+	}
+
 	fmt.Printf("Executing plan: %s@%s\n", plan.Tool, plan.Version)
 	fmt.Printf("   Work directory: %s\n", e.workDir)
 
@@ -334,6 +394,8 @@ func (e *Executor) ExecutePlan(ctx context.Context, plan *InstallationPlan) erro
 
 	fmt.Println()
 
+	planStart := time.Now()
+
 	// Execute each step (including flattened dependency steps)
 	for i, step := range allSteps {
 		// Check for context cancellation
@@ -342,6 +404,10 @@ func (e *Executor) ExecutePlan(ctx context.Context, plan *InstallationPlan) erro
 		}
 
 		fmt.Printf("Step %d/%d: %s\n", i+1, len(allSteps), step.Action)
+		stepStart := time.Now()
+		if e.notifier != nil {
+			e.notifier.Emit(notify.NewStepStartedEvent(plan.Tool, plan.Version, i+1, step.Action))
+		}
 
 		// Get action
 		action := actions.Get(step.Action)
@@ -350,15 +416,21 @@ func (e *Executor) ExecutePlan(ctx context.Context, plan *InstallationPlan) erro
 		}
 
 		// For download steps with checksums, verify after download
-		if step.Action == "download" && step.Checksum != "" {
-			if err := e.executeDownloadWithVerification(ctx, execCtx, step, plan); err != nil {
-				return fmt.Errorf("step %d (%s) failed: %w", i+1, step.Action, err)
-			}
+		var stepErr error
+		if step.Action == "download" && !step.Checksum.IsZero() {
+			stepErr = e.executeDownloadWithVerification(ctx, execCtx, step, plan)
 		} else {
 			// Execute other steps normally
-			if err := action.Execute(execCtx, step.Params); err != nil {
-				return fmt.Errorf("step %d (%s) failed: %w", i+1, step.Action, err)
+			stepErr = action.Execute(execCtx, step.Params)
+		}
+		if stepErr != nil {
+			if e.notifier != nil {
+				e.notifier.Emit(notify.NewStepFailedEvent(plan.Tool, plan.Version, i+1, step.Action, time.Since(stepStart), stepErr.Error()))
 			}
+			return fmt.Errorf("step %d (%s) failed: %w", i+1, step.Action, stepErr)
+		}
+		if e.notifier != nil {
+			e.notifier.Emit(notify.NewStepCompletedEvent(plan.Tool, plan.Version, i+1, step.Action, time.Since(stepStart)))
 		}
 
 		// After install_binaries completes, add the bin directory to ExecPaths
@@ -383,6 +455,10 @@ func (e *Executor) ExecutePlan(ctx context.Context, plan *InstallationPlan) erro
 		fmt.Println()
 	}
 
+	if e.notifier != nil {
+		e.notifier.Emit(notify.NewPlanCompletedEvent(plan.Tool, plan.Version, time.Since(planStart)))
+	}
+
 	return nil
 }
 
@@ -436,25 +512,23 @@ func (e *Executor) executeDownloadWithVerification(
 	// Determine the destination file path
 	destPath := e.resolveDownloadDest(step, execCtx)
 
-	// Compute checksum of downloaded file
-	actualChecksum, err := computeFileChecksum(destPath)
+	file, err := os.Open(destPath)
 	if err != nil {
-		return fmt.Errorf("failed to compute checksum: %w", err)
+		return fmt.Errorf("failed to open downloaded file: %w", err)
 	}
+	defer file.Close()
 
-	// Verify checksum matches plan
-	expectedChecksum := strings.ToLower(strings.TrimSpace(step.Checksum))
-	// Strip algorithm prefix if present (e.g., "sha256:abc123" -> "abc123")
-	if idx := strings.Index(expectedChecksum, ":"); idx != -1 {
-		expectedChecksum = expectedChecksum[idx+1:]
-	}
-	if actualChecksum != expectedChecksum {
+	if err := step.Checksum.Verify(file); err != nil {
+		actualChecksum, sumErr := computeFileChecksum(destPath, step.Checksum.Algorithm)
+		if sumErr != nil {
+			return fmt.Errorf("failed to compute checksum: %w", sumErr)
+		}
 		return &ChecksumMismatchError{
 			Tool:             plan.Tool,
 			Version:          plan.Version,
 			URL:              step.URL,
-			ExpectedChecksum: expectedChecksum,
-			ActualChecksum:   actualChecksum,
+			ExpectedChecksum: step.Checksum.String(),
+			ActualChecksum:   fmt.Sprintf("%s:%s", step.Checksum.Algorithm, actualChecksum),
 		}
 	}
 
@@ -491,15 +565,18 @@ func (e *Executor) resolveDownloadDest(step ResolvedStep, execCtx *actions.Execu
 	return ""
 }
 
-// computeFileChecksum computes the SHA256 checksum of a file.
-func computeFileChecksum(path string) (string, error) {
+// computeFileChecksum computes a file's checksum using the given algorithm.
+func computeFileChecksum(path string, algo HashAlgorithm) (string, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return "", fmt.Errorf("failed to open file: %w", err)
 	}
 	defer file.Close()
 
-	h := sha256.New()
+	h, err := newHasher(algo)
+	if err != nil {
+		return "", err
+	}
 	if _, err := io.Copy(h, file); err != nil {
 		return "", fmt.Errorf("failed to hash file: %w", err)
 	}
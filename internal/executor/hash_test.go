@@ -0,0 +1,201 @@
+package executor
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseHash(t *testing.T) {
+	sha256Hex := strings.Repeat("a", 64)
+	sha512Hex := strings.Repeat("b", 128)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    Hash
+		wantErr bool
+	}{
+		{
+			name:  "empty string parses to zero Hash",
+			input: "",
+			want:  Hash{},
+		},
+		{
+			name:  "bare hex defaults to sha256",
+			input: sha256Hex,
+			want:  Hash{Algorithm: HashAlgorithmSHA256, Value: sha256Hex},
+		},
+		{
+			name:  "explicit sha256 prefix",
+			input: "sha256:" + sha256Hex,
+			want:  Hash{Algorithm: HashAlgorithmSHA256, Value: sha256Hex},
+		},
+		{
+			name:  "explicit sha512 prefix",
+			input: "sha512:" + sha512Hex,
+			want:  Hash{Algorithm: HashAlgorithmSHA512, Value: sha512Hex},
+		},
+		{
+			name:  "uppercase hex is lowercased",
+			input: "sha256:" + strings.ToUpper(sha256Hex),
+			want:  Hash{Algorithm: HashAlgorithmSHA256, Value: sha256Hex},
+		},
+		{
+			name:    "unknown algorithm",
+			input:   "md5:" + sha256Hex,
+			wantErr: true,
+		},
+		{
+			name:    "wrong digest length for algorithm",
+			input:   "sha256:abc123",
+			wantErr: true,
+		},
+		{
+			name:    "non-hex digest",
+			input:   "sha256:" + strings.Repeat("z", 64),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseHash(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("ParseHash(%q) = nil error, want error", tc.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseHash(%q) unexpected error: %v", tc.input, err)
+			}
+			if got != tc.want {
+				t.Errorf("ParseHash(%q) = %+v, want %+v", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHash_String(t *testing.T) {
+	if got := (Hash{}).String(); got != "" {
+		t.Errorf("zero Hash.String() = %q, want empty string", got)
+	}
+
+	h := Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"}
+	if got, want := h.String(), "sha256:abc123"; got != want {
+		t.Errorf("Hash.String() = %q, want %q", got, want)
+	}
+}
+
+func TestHash_IsZero(t *testing.T) {
+	if !(Hash{}).IsZero() {
+		t.Error("zero Hash.IsZero() = false, want true")
+	}
+	if (Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"}).IsZero() {
+		t.Error("non-empty Hash.IsZero() = true, want false")
+	}
+}
+
+func TestHash_Validate(t *testing.T) {
+	sha256Hex := strings.Repeat("a", 64)
+
+	tests := []struct {
+		name    string
+		hash    Hash
+		wantErr bool
+	}{
+		{name: "zero hash is valid", hash: Hash{}},
+		{name: "valid sha256", hash: Hash{Algorithm: HashAlgorithmSHA256, Value: sha256Hex}},
+		{name: "unknown algorithm", hash: Hash{Algorithm: "md5", Value: sha256Hex}, wantErr: true},
+		{name: "wrong length for algorithm", hash: Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"}, wantErr: true},
+		{name: "non-hex digest", hash: Hash{Algorithm: HashAlgorithmSHA256, Value: strings.Repeat("z", 64)}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.hash.Validate()
+			if tc.wantErr && err == nil {
+				t.Error("Validate() = nil, want error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestHash_Verify(t *testing.T) {
+	t.Run("sha256 match", func(t *testing.T) {
+		data := "hello world"
+		// SHA-256 of "hello world"
+		want := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+		h := Hash{Algorithm: HashAlgorithmSHA256, Value: want}
+		if err := h.Verify(strings.NewReader(data)); err != nil {
+			t.Errorf("Verify() = %v, want nil", err)
+		}
+	})
+
+	t.Run("mismatch returns error", func(t *testing.T) {
+		h := Hash{Algorithm: HashAlgorithmSHA256, Value: strings.Repeat("0", 64)}
+		if err := h.Verify(strings.NewReader("hello world")); err == nil {
+			t.Error("Verify() = nil, want error for mismatched digest")
+		}
+	})
+
+	t.Run("empty hash returns error", func(t *testing.T) {
+		if err := (Hash{}).Verify(strings.NewReader("hello world")); err == nil {
+			t.Error("Verify() = nil, want error for empty checksum")
+		}
+	})
+
+	t.Run("blake3 is recognized but unsupported", func(t *testing.T) {
+		h := Hash{Algorithm: HashAlgorithmBLAKE3, Value: strings.Repeat("a", 64)}
+		if err := h.Verify(strings.NewReader("hello world")); err == nil {
+			t.Error("Verify() = nil, want error for unimplemented blake3 verification")
+		}
+	})
+}
+
+func TestHash_JSONRoundTrip(t *testing.T) {
+	sha256Hex := strings.Repeat("a", 64)
+
+	tests := []struct {
+		name string
+		hash Hash
+	}{
+		{name: "zero hash", hash: Hash{}},
+		{name: "sha256", hash: Hash{Algorithm: HashAlgorithmSHA256, Value: sha256Hex}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.hash)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+
+			var roundtrip Hash
+			if err := json.Unmarshal(data, &roundtrip); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if roundtrip != tc.hash {
+				t.Errorf("round trip = %+v, want %+v", roundtrip, tc.hash)
+			}
+		})
+	}
+}
+
+func TestHash_UnmarshalJSON_LegacyBareHex(t *testing.T) {
+	sha256Hex := strings.Repeat("a", 64)
+
+	var h Hash
+	if err := json.Unmarshal([]byte(`"`+sha256Hex+`"`), &h); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	want := Hash{Algorithm: HashAlgorithmSHA256, Value: sha256Hex}
+	if h != want {
+		t.Errorf("legacy bare-hex Checksum = %+v, want %+v", h, want)
+	}
+}
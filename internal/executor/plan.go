@@ -13,7 +13,10 @@ import (
 // Version history:
 //   - Version 1: Original format with composite actions in plans
 //   - Version 2: Composite actions decomposed to primitives (introduced in #440)
-const PlanFormatVersion = 2
+//   - Version 3: Checksum is a structured Hash (algorithm + digest) instead
+//     of an ad-hoc "sha256:hex" string; version-2 plans still load, since
+//     Hash's JSON decoding treats a bare hex string as SHA-256.
+const PlanFormatVersion = 3
 
 // InstallationPlan represents a fully-resolved, deterministic specification
 // for installing a tool. Plans capture the exact URLs, checksums, and steps
@@ -83,8 +86,8 @@ type ResolvedStep struct {
 
 	// For download steps only - these capture the resolved URL and computed checksum
 	URL      string `json:"url,omitempty"`
-	Checksum string `json:"checksum,omitempty"` // SHA256 in hex format
-	Size     int64  `json:"size,omitempty"`     // File size in bytes
+	Checksum Hash   `json:"checksum"`       // Algorithm + hex digest; zero value marshals to ""
+	Size     int64  `json:"size,omitempty"` // File size in bytes
 }
 
 // ActionEvaluability classifies actions by whether they can be deterministically
@@ -178,13 +181,16 @@ func (e *PlanValidationError) Error() string {
 }
 
 // ValidatePlan checks that a plan contains only primitive actions and that
-// download actions have required checksum data. Returns nil if the plan is valid,
-// or a PlanValidationError containing all validation failures.
+// file-producing actions have required checksum data. Returns nil if the plan
+// is valid, or a PlanValidationError containing all validation failures.
 //
 // Validation rules:
 //   - All step actions must be primitives (as defined by actions.IsPrimitive)
-//   - Download actions must have a non-empty Checksum field (security requirement)
-//   - Format version must be supported (currently only version 2)
+//   - Actions that fetch a file (as defined by isDownloadAction) must have a
+//     non-empty Checksum field (security requirement)
+//   - A non-empty Checksum must name a known algorithm and a digest of the
+//     matching hex length
+//   - Format version must be supported (currently versions 2 and 3)
 func ValidatePlan(plan *InstallationPlan) error {
 	var errors []ValidationError
 
@@ -193,7 +199,7 @@ func ValidatePlan(plan *InstallationPlan) error {
 		errors = append(errors, ValidationError{
 			Step:    -1,
 			Action:  "",
-			Message: fmt.Sprintf("unsupported plan format version %d (expected >= 2)", plan.FormatVersion),
+			Message: fmt.Sprintf("unsupported plan format version %d (expected 2 or 3)", plan.FormatVersion),
 		})
 	}
 
@@ -223,13 +229,25 @@ func ValidatePlan(plan *InstallationPlan) error {
 			}
 		}
 
-		// Check checksum for download actions
-		if step.Action == "download" && step.Checksum == "" {
-			errors = append(errors, ValidationError{
-				Step:    i,
-				Action:  step.Action,
-				Message: "download action missing checksum (security requirement)",
-			})
+		// Check checksum for actions that fetch a file. This covers not just
+		// the bare "download" primitive but also the composite download
+		// actions (download_archive, github_archive, github_file,
+		// hashicorp_release, homebrew_bottle) for the rare plan that still
+		// carries one of them unexpanded - see isDownloadAction.
+		if isDownloadAction(step.Action) {
+			if step.Checksum.IsZero() {
+				errors = append(errors, ValidationError{
+					Step:    i,
+					Action:  step.Action,
+					Message: "download action missing checksum (security requirement)",
+				})
+			} else if err := step.Checksum.Validate(); err != nil {
+				errors = append(errors, ValidationError{
+					Step:    i,
+					Action:  step.Action,
+					Message: err.Error(),
+				})
+			}
 		}
 	}
 
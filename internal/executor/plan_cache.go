@@ -169,7 +169,7 @@ func planContentForHashing(plan *InstallationPlan) planForHashing {
 	for i, step := range plan.Steps {
 		result.Steps[i] = stepForHashing{
 			Action:        step.Action,
-			Checksum:      step.Checksum,
+			Checksum:      step.Checksum.String(),
 			Deterministic: step.Deterministic,
 			Evaluable:     step.Evaluable,
 			Params:        sortedParams(step.Params),
@@ -211,7 +211,7 @@ func convertDepsForHashing(deps []DependencyPlan) []depForHashing {
 		for j, step := range dep.Steps {
 			result[i].Steps[j] = stepForHashing{
 				Action:        step.Action,
-				Checksum:      step.Checksum,
+				Checksum:      step.Checksum.String(),
 				Deterministic: step.Deterministic,
 				Evaluable:     step.Evaluable,
 				Params:        sortedParams(step.Params),
@@ -240,7 +240,7 @@ func TestComputePlanContentHash(t *testing.T) {
 					Evaluable:     true,
 					Deterministic: true,
 					URL:           "https://example.com/file.tar.gz",
-					Checksum:      "sha256:deadbeef",
+					Checksum:      Hash{Algorithm: HashAlgorithmSHA256, Value: "deadbeef"},
 				},
 			},
 		}
@@ -266,7 +266,7 @@ func TestComputePlanContentHash(t *testing.T) {
 			Platform:      Platform{OS: "darwin", Arch: "arm64"},
 			Deterministic: true,
 			Steps: []ResolvedStep{
-				{Action: "download_file", URL: "https://example.com/gh.tar.gz", Checksum: "abc123"},
+				{Action: "download_file", URL: "https://example.com/gh.tar.gz", Checksum: Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"}},
 				{Action: "extract", Params: map[string]interface{}{"format": "tar.gz"}},
 			},
 		}
@@ -278,7 +278,7 @@ func TestComputePlanContentHash(t *testing.T) {
 			Platform:      Platform{OS: "darwin", Arch: "arm64"},
 			Deterministic: true,
 			Steps: []ResolvedStep{
-				{Action: "download_file", URL: "https://example.com/gh.tar.gz", Checksum: "abc123"},
+				{Action: "download_file", URL: "https://example.com/gh.tar.gz", Checksum: Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"}},
 				{Action: "extract", Params: map[string]interface{}{"format": "tar.gz"}},
 			},
 		}
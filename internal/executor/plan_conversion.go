@@ -18,7 +18,7 @@ func ToStoragePlan(plan *InstallationPlan) *install.Plan {
 			Evaluable:     s.Evaluable,
 			Deterministic: s.Deterministic,
 			URL:           s.URL,
-			Checksum:      s.Checksum,
+			Checksum:      s.Checksum.String(),
 			Size:          s.Size,
 		}
 	}
@@ -49,13 +49,17 @@ func FromStoragePlan(plan *install.Plan) *InstallationPlan {
 	// Convert steps
 	steps := make([]ResolvedStep, len(plan.Steps))
 	for i, s := range plan.Steps {
+		// Ignore parse errors: a malformed checksum in stored state degrades
+		// to a zero Hash, which ValidatePlan rejects on re-execution rather
+		// than this conversion silently failing.
+		checksum, _ := ParseHash(s.Checksum)
 		steps[i] = ResolvedStep{
 			Action:        s.Action,
 			Params:        s.Params,
 			Evaluable:     s.Evaluable,
 			Deterministic: s.Deterministic,
 			URL:           s.URL,
-			Checksum:      s.Checksum,
+			Checksum:      checksum,
 			Size:          s.Size,
 		}
 	}
@@ -37,7 +37,7 @@ func TestToStoragePlan(t *testing.T) {
 					Evaluable:     true,
 					Deterministic: true,
 					URL:           "https://example.com/file.tar.gz",
-					Checksum:      "sha256:deadbeef",
+					Checksum:      Hash{Algorithm: HashAlgorithmSHA256, Value: "deadbeef"},
 					Size:          12345,
 				},
 				{
@@ -210,7 +210,7 @@ func TestRoundTripConversion(t *testing.T) {
 					Evaluable:     true,
 					Deterministic: true,
 					URL:           "https://example.com/kubectl.tar.gz",
-					Checksum:      "sha256:abcd1234",
+					Checksum:      Hash{Algorithm: HashAlgorithmSHA256, Value: "abcd1234"},
 					Size:          50000000,
 				},
 				{
@@ -190,7 +190,7 @@ func (e *Executor) resolveStep(
 			}
 			defer func() { _ = result.Cleanup() }()
 
-			resolved.Checksum = result.Checksum
+			resolved.Checksum = Hash{Algorithm: HashAlgorithmSHA256, Value: result.Checksum}
 			resolved.Size = result.Size
 		}
 	}
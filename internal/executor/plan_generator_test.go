@@ -828,7 +828,7 @@ func TestResolveStep_WithDownload(t *testing.T) {
 
 		// If we got here with a successful plan, the checksum should be computed
 		// (and more importantly, the defer cleanup should have been called)
-		if step.Checksum == "" {
+		if step.Checksum.IsZero() {
 			t.Error("Checksum should be computed for download action")
 		}
 		if step.Size == 0 {
@@ -1237,7 +1237,7 @@ func TestGeneratePlan_WithDownloadAction(t *testing.T) {
 	if step.URL == "" {
 		t.Error("step.URL should not be empty for download_file action")
 	}
-	if step.Checksum == "" {
+	if step.Checksum.IsZero() {
 		t.Error("step.Checksum should not be empty after download")
 	}
 	if step.Size == 0 {
@@ -1342,7 +1342,7 @@ func TestGeneratePlan_HomebrewSkipsChecksum(t *testing.T) {
 	if step.URL != "" {
 		t.Errorf("homebrew step.URL should be empty, got %q", step.URL)
 	}
-	if step.Checksum != "" {
+	if !step.Checksum.IsZero() {
 		t.Errorf("homebrew step.Checksum should be empty, got %q", step.Checksum)
 	}
 }
@@ -1497,7 +1497,7 @@ func TestGeneratePlan_AllDownloadActionTypes(t *testing.T) {
 				t.Errorf("step.URL present = %v, want %v (URL: %q)", hasURL, tt.expectURL, step.URL)
 			}
 
-			if tt.expectURL && step.Checksum == "" {
+			if tt.expectURL && step.Checksum.IsZero() {
 				t.Error("expected checksum to be computed when URL is present")
 			}
 		})
@@ -1638,7 +1638,7 @@ func TestGeneratePlan_PreDownloaderAdapter(t *testing.T) {
 		if step.URL == "" {
 			t.Error("download_file step should have URL")
 		}
-		if step.Checksum == "" {
+		if step.Checksum.IsZero() {
 			t.Error("download_file step should have checksum computed")
 		}
 	}
@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tsukumogami/tsuku/internal/plansign"
+)
+
+// PlanDigest returns the canonical digest of plan that gets signed and
+// verified. It hashes the plan's JSON encoding rather than RecipeHash alone,
+// since a signature over a plan attests to the resolved steps (URLs,
+// checksums, verify command) as well as the recipe they came from.
+func PlanDigest(plan *InstallationPlan) ([]byte, error) {
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal plan for signing: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// SignPlan signs plan's digest with signer.
+func SignPlan(plan *InstallationPlan, signer plansign.Signer) (*plansign.Signature, error) {
+	digest, err := PlanDigest(plan)
+	if err != nil {
+		return nil, err
+	}
+	return plansign.Sign(digest, signer)
+}
+
+// VerifyPlan checks that sig is a valid signature over plan's digest from a
+// signer trust permits for plan.RecipeSource. It returns the verified
+// identity.
+func VerifyPlan(plan *InstallationPlan, sig *plansign.Signature, trust *plansign.TrustPolicy) (string, error) {
+	digest, err := PlanDigest(plan)
+	if err != nil {
+		return "", err
+	}
+	return plansign.Verify(digest, sig, plan.RecipeSource, trust)
+}
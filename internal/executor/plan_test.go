@@ -25,7 +25,7 @@ func TestInstallationPlanJSONRoundTrip(t *testing.T) {
 				Params:    map[string]interface{}{"strip_dirs": float64(1)},
 				Evaluable: true,
 				URL:       "https://github.com/BurntSushi/ripgrep/releases/download/14.1.0/ripgrep-14.1.0-x86_64-unknown-linux-musl.tar.gz",
-				Checksum:  "sha256:abcdef123456",
+				Checksum:  Hash{Algorithm: HashAlgorithmSHA256, Value: "abcdef123456"},
 				Size:      1234567,
 			},
 			{
@@ -90,7 +90,7 @@ func TestResolvedStepJSONRoundTrip(t *testing.T) {
 				Params:    map[string]interface{}{"url": "https://example.com/file.tar.gz"},
 				Evaluable: true,
 				URL:       "https://example.com/file.tar.gz",
-				Checksum:  "sha256:abc123",
+				Checksum:  Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"},
 				Size:      12345,
 			},
 		},
@@ -178,7 +178,7 @@ func TestJSONFieldNames(t *testing.T) {
 		RecipeHash:    "hash",
 		RecipeSource:  "source",
 		Steps: []ResolvedStep{
-			{Action: "download", Params: map[string]interface{}{}, Evaluable: true, URL: "url", Checksum: "sum", Size: 100},
+			{Action: "download", Params: map[string]interface{}{}, Evaluable: true, URL: "url", Checksum: Hash{Algorithm: HashAlgorithmSHA256, Value: "sum"}, Size: 100},
 		},
 	}
 
@@ -336,7 +336,7 @@ func TestValidatePlan_AllPrimitives(t *testing.T) {
 				Params:    map[string]interface{}{"url": "https://example.com/file.tar.gz"},
 				Evaluable: true,
 				URL:       "https://example.com/file.tar.gz",
-				Checksum:  "sha256:abc123",
+				Checksum:  Hash{Algorithm: HashAlgorithmSHA256, Value: "abc123"},
 				Size:      1234,
 			},
 			{
@@ -456,6 +456,37 @@ func TestValidatePlan_MissingChecksum(t *testing.T) {
 	}
 }
 
+func TestValidatePlan_MissingChecksum_CompositeDownloadAction(t *testing.T) {
+	// The checksum requirement isn't limited to the "download" primitive - it
+	// also covers the other actions isDownloadAction recognizes as fetching a
+	// file (download_archive, github_archive, github_file, hashicorp_release,
+	// homebrew_bottle).
+	plan := &InstallationPlan{
+		FormatVersion: 2,
+		Tool:          "test-tool",
+		Version:       "1.0.0",
+		Platform:      Platform{OS: "linux", Arch: "amd64"},
+		Steps: []ResolvedStep{
+			{
+				Action:    "github_file",
+				Params:    map[string]interface{}{"repo": "owner/repo"},
+				Evaluable: true,
+				// Missing Checksum field - should fail
+			},
+		},
+	}
+
+	err := ValidatePlan(plan)
+	if err == nil {
+		t.Fatal("ValidatePlan() should return error for github_file without checksum")
+	}
+
+	errMsg := err.Error()
+	if !contains(errMsg, "checksum") {
+		t.Errorf("error message should mention 'checksum', got: %s", errMsg)
+	}
+}
+
 func TestValidatePlan_EmptyPlan(t *testing.T) {
 	// Empty plan (no steps) should pass validation
 	plan := &InstallationPlan{
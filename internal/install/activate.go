@@ -0,0 +1,68 @@
+package install
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Activate switches a tool's active version, recreating the symlinks in
+// current/ to point at the requested version. Activating the already-active
+// version is a no-op success.
+func (m *Manager) Activate(name, version string) (err error) {
+	defer func() {
+		if err != nil {
+			err = &ExecError{Op: "activate", Tool: name, Version: version, Err: err}
+		}
+	}()
+
+	if strings.ContainsAny(version, "/\\") || strings.Contains(version, "..") {
+		return fmt.Errorf("invalid version %q: must not contain path separators", version)
+	}
+
+	toolState, err := m.state.GetToolState(name)
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if toolState == nil || len(toolState.Versions) == 0 {
+		return fmt.Errorf("tool %q is not installed", name)
+	}
+
+	versionState, exists := toolState.Versions[version]
+	if !exists {
+		available := make([]string, 0, len(toolState.Versions))
+		for v := range toolState.Versions {
+			available = append(available, v)
+		}
+		sort.Strings(available)
+		return fmt.Errorf("version %s of %q is not installed (available: %s)", version, name, strings.Join(available, ", "))
+	}
+
+	if toolState.ActiveVersion == version {
+		return nil
+	}
+
+	toolDir := m.config.ToolDir(name, version)
+	if _, err := os.Stat(toolDir); os.IsNotExist(err) {
+		return fmt.Errorf("tool directory missing for %s@%s: %s", name, version, toolDir)
+	}
+
+	if err := m.createSymlinksForBinaries(name, version, versionState.Binaries); err != nil {
+		return fmt.Errorf("failed to activate %s@%s: %w", name, version, err)
+	}
+
+	if err := m.state.UpdateTool(name, func(ts *ToolState) {
+		ts.ActiveVersion = version
+		if vs, ok := ts.Versions[version]; ok {
+			vs.LastUsedAt = timeNow()
+			ts.Versions[version] = vs
+		}
+	}); err != nil {
+		return err
+	}
+
+	m.log().Info("activated tool version", "tool", name, "version", version)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package install
+
+import "fmt"
+
+// ExecError wraps a failure from a Manager operation with the operation
+// name and the tool/version it concerned, so embedders can branch on
+// structured fields instead of string-matching Error() text the way the
+// CLI's fmt.Fprintf/exitWithCode error paths do today.
+type ExecError struct {
+	Op      string // e.g. "install", "activate", "remove"
+	Tool    string
+	Version string // empty when the operation isn't version-specific
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *ExecError) Error() string {
+	if e.Version != "" {
+		return fmt.Sprintf("%s %s@%s: %v", e.Op, e.Tool, e.Version, e.Err)
+	}
+	return fmt.Sprintf("%s %s: %v", e.Op, e.Tool, e.Err)
+}
+
+// Unwrap allows errors.Is/errors.As to see through to the underlying error.
+func (e *ExecError) Unwrap() error {
+	return e.Err
+}
@@ -3,15 +3,15 @@ package install
 import (
 	"fmt"
 	"os"
-	"path/filepath"
-	"strings"
+	"sort"
 )
 
-// InstalledTool represents an installed tool
+// InstalledTool represents a single installed tool version.
 type InstalledTool struct {
-	Name    string
-	Version string
-	Path    string
+	Name     string
+	Version  string
+	Path     string
+	IsActive bool // Whether this is the version currently symlinked into current/
 }
 
 // List returns a list of all installed tools (excluding hidden tools)
@@ -24,57 +24,57 @@ func (m *Manager) ListAll() ([]InstalledTool, error) {
 	return m.ListWithOptions(true)
 }
 
-// ListWithOptions returns a list of installed tools with option to include hidden
+// ListWithOptions returns a list of installed tools with option to include hidden.
+// Listing is driven by state (ToolState.Versions), not by scanning the tools
+// directory, so every installed version of every tool is reported even when
+// multiple versions share a tool directory. Versions whose directory is
+// missing from disk are treated as stale and omitted.
 func (m *Manager) ListWithOptions(includeHidden bool) ([]InstalledTool, error) {
-	// Ensure tools directory exists
-	if _, err := os.Stat(m.config.ToolsDir); os.IsNotExist(err) {
-		return []InstalledTool{}, nil
-	}
-
-	// Load state to check for hidden tools
 	state, err := m.state.Load()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load state: %w", err)
 	}
 
-	entries, err := os.ReadDir(m.config.ToolsDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read tools directory: %w", err)
-	}
-
 	var tools []InstalledTool
 
-	for _, entry := range entries {
-		if !entry.IsDir() || entry.Name() == "current" {
-			continue
-		}
-
-		// Expected format: name-version
-		// We need to find the last hyphen to separate name and version
-		name := entry.Name()
-		lastHyphen := strings.LastIndex(name, "-")
-
-		if lastHyphen == -1 || lastHyphen == 0 || lastHyphen == len(name)-1 {
-			// Invalid format, skip
+	for toolName, toolState := range state.Installed {
+		if !includeHidden && toolState.IsHidden {
 			continue
 		}
 
-		toolName := name[:lastHyphen]
-		toolVersion := name[lastHyphen+1:]
-
-		// Check if tool is hidden (unless we're including hidden)
-		if !includeHidden {
-			if toolState, exists := state.Installed[toolName]; exists && toolState.IsHidden {
+		for version := range toolState.Versions {
+			toolDir := m.config.ToolDir(toolName, version)
+			if _, err := os.Stat(toolDir); os.IsNotExist(err) {
+				// Stale state entry: directory was removed outside of tsuku.
 				continue
 			}
-		}
 
-		tools = append(tools, InstalledTool{
-			Name:    toolName,
-			Version: toolVersion,
-			Path:    filepath.Join(m.config.ToolsDir, name),
-		})
+			tools = append(tools, InstalledTool{
+				Name:     toolName,
+				Version:  version,
+				Path:     toolDir,
+				IsActive: version == toolState.ActiveVersion,
+			})
+		}
 	}
 
+	sort.Slice(tools, func(i, j int) bool {
+		if tools[i].Name != tools[j].Name {
+			return tools[i].Name < tools[j].Name
+		}
+		return tools[i].Version < tools[j].Version
+	})
+
 	return tools, nil
 }
+
+// IsVersionInstalled reports whether a specific version of a tool is recorded
+// in state, regardless of whether it is the active version.
+func (m *Manager) IsVersionInstalled(name, version string) bool {
+	toolState, err := m.state.GetToolState(name)
+	if err != nil || toolState == nil {
+		return false
+	}
+	_, exists := toolState.Versions[version]
+	return exists
+}
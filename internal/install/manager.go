@@ -9,22 +9,43 @@ import (
 	"strings"
 
 	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/log"
+	"github.com/tsukumogami/tsuku/internal/plugin"
+	"github.com/tsukumogami/tsuku/internal/registry"
 )
 
 // Manager handles tool installation to ~/.tsuku
 type Manager struct {
-	config *config.Config
-	state  *StateManager
+	config  *config.Config
+	state   *StateManager
+	plugins []*plugin.Plugin
+
+	// logger and registry are only set when the Manager is constructed via
+	// NewManager(Options); New(cfg) leaves them at their zero values, and
+	// every method falls back to New's historical behavior (fmt.Println
+	// progress output, log.Default()) or has no use for a registry at all.
+	logger   log.Logger
+	registry *registry.Registry
+	hooks    Hooks
 }
 
-// New creates a new install manager
+// New creates a new install manager. At construction time it discovers
+// external action plugins under cfg.PluginsDir and registers their actions,
+// so they're available to every subsequent plan generation and execution.
 func New(cfg *config.Config) *Manager {
 	return &Manager{
-		config: cfg,
-		state:  NewStateManager(cfg),
+		config:  cfg,
+		state:   NewStateManager(cfg),
+		plugins: plugin.DiscoverAndRegister(cfg.PluginsDir),
 	}
 }
 
+// Plugins returns the external action plugins discovered at construction
+// time, for commands like `tsuku plugin list`.
+func (m *Manager) Plugins() []*plugin.Plugin {
+	return m.plugins
+}
+
 // InstallOptions controls how a tool is installed
 type InstallOptions struct {
 	CreateSymlinks      bool              // Whether to create symlinks in current/
@@ -49,7 +70,13 @@ func (m *Manager) Install(name, version, workDir string) error {
 
 // InstallWithOptions copies a tool from the work directory to the permanent location
 // with custom options for symlink creation and visibility
-func (m *Manager) InstallWithOptions(name, version, workDir string, opts InstallOptions) error {
+func (m *Manager) InstallWithOptions(name, version, workDir string, opts InstallOptions) (err error) {
+	defer func() {
+		if err != nil {
+			err = &ExecError{Op: "install", Tool: name, Version: version, Err: err}
+		}
+	}()
+
 	// Ensure directories exist
 	if err := m.config.EnsureDirectories(); err != nil {
 		return err
@@ -80,32 +107,32 @@ func (m *Manager) InstallWithOptions(name, version, workDir string, opts Install
 			if err := m.createWrappersForBinaries(name, version, opts.Binaries, opts.RuntimeDependencies); err != nil {
 				return fmt.Errorf("failed to create wrappers: %w", err)
 			}
-			fmt.Printf("📍 Installed to: %s\n", toolDir)
+			m.logProgress("📍 Installed to: %s", toolDir)
 			if len(opts.Binaries) > 0 {
-				fmt.Printf("🔗 Wrapped %d binaries: %v\n", len(opts.Binaries), opts.Binaries)
+				m.logProgress("🔗 Wrapped %d binaries: %v", len(opts.Binaries), opts.Binaries)
 			} else {
-				fmt.Printf("🔗 Wrapped: %s\n", m.config.CurrentSymlink(name))
+				m.logProgress("🔗 Wrapped: %s", m.config.CurrentSymlink(name))
 			}
 		} else {
 			// No runtime deps - use symlinks (faster)
 			if err := m.createSymlinksForBinaries(name, version, opts.Binaries); err != nil {
 				return fmt.Errorf("failed to create symlinks: %w", err)
 			}
-			fmt.Printf("📍 Installed to: %s\n", toolDir)
+			m.logProgress("📍 Installed to: %s", toolDir)
 			if len(opts.Binaries) > 0 {
-				fmt.Printf("🔗 Symlinked %d binaries: %v\n", len(opts.Binaries), opts.Binaries)
+				m.logProgress("🔗 Symlinked %d binaries: %v", len(opts.Binaries), opts.Binaries)
 			} else {
-				fmt.Printf("🔗 Symlinked: %s -> %s\n", m.config.CurrentSymlink(name), filepath.Join(toolDir, "bin", name))
+				m.logProgress("🔗 Symlinked: %s -> %s", m.config.CurrentSymlink(name), filepath.Join(toolDir, "bin", name))
 			}
 		}
 	} else {
-		fmt.Printf("📍 Installed to: %s (hidden)\n", toolDir)
+		m.logProgress("📍 Installed to: %s (hidden)", toolDir)
 	}
 
 	// Update state
 	// Note: IsExplicit and RequiredBy are handled by the caller (main.go)
 	// Here we just ensure the version is recorded
-	err := m.state.UpdateTool(name, func(ts *ToolState) {
+	err = m.state.UpdateTool(name, func(ts *ToolState) {
 		ts.Version = version
 		ts.Binaries = opts.Binaries
 		if opts.IsHidden {
@@ -117,6 +144,8 @@ func (m *Manager) InstallWithOptions(name, version, workDir string, opts Install
 		return fmt.Errorf("failed to update state: %w", err)
 	}
 
+	m.log().Info("installed tool", "tool", name, "version", version, "hidden", opts.IsHidden)
+
 	return nil
 }
 
@@ -125,6 +154,20 @@ func (m *Manager) GetState() *StateManager {
 	return m.state
 }
 
+// Config returns the on-disk layout this Manager was constructed with, for
+// embedders that need to locate a tool's download cache or tools directory
+// (e.g. to set up an executor.Executor before calling InstallWithOptions).
+func (m *Manager) Config() *config.Config {
+	return m.config
+}
+
+// Registry returns the recipe registry this Manager was constructed with
+// via NewManager(Options{Registry: ...}) or Options{HTTPClient: ...}, or nil
+// for a Manager constructed via New, which doesn't resolve recipes itself.
+func (m *Manager) Registry() *registry.Registry {
+	return m.registry
+}
+
 // createSymlink creates or updates the symlink in current/ to point to the latest version
 // This assumes the binary name matches the tool name (legacy behavior)
 func (m *Manager) createSymlink(name, version string) error {
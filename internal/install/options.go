@@ -0,0 +1,110 @@
+package install
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/log"
+	"github.com/tsukumogami/tsuku/internal/registry"
+)
+
+// Hooks lets an embedder observe a Manager's operations without going
+// through stdout the way the cobra commands do. A nil hook falls back to
+// the CLI's historical behavior, so existing callers of New/install.New(cfg)
+// see no change.
+type Hooks struct {
+	// OnProgress reports a human-readable progress message (e.g. "Installed
+	// to: /home/user/.tsuku/tools/ripgrep-14.1.0"). If nil, progress
+	// messages are printed to stdout, matching New's historical behavior.
+	OnProgress func(message string)
+}
+
+// Options configures a Manager for use outside the tsuku CLI. Every field
+// is optional; a zero-value Options behaves like install.New(cfg) with
+// cfg from config.DefaultConfig().
+type Options struct {
+	// Config supplies the on-disk layout (tools dir, state file, plugins
+	// dir, etc). If nil, config.DefaultConfig() is used.
+	Config *config.Config
+
+	// Store persists installed-tool state (the data NewStateManager reads
+	// and writes). If nil, a StateManager rooted at Config is constructed.
+	// Tests and embedders that want an isolated or in-memory store should
+	// construct their own StateManager and set it here instead of relying
+	// on Config's on-disk location.
+	Store *StateManager
+
+	// Logger receives diagnostic output. If nil, log.Default() is used.
+	Logger log.Logger
+
+	// HTTPClient configures the Registry this Manager constructs when
+	// Registry is left nil, letting embedders set a custom timeout or
+	// transport. Ignored when Registry is set directly.
+	HTTPClient *http.Client
+
+	// Registry resolves recipes by name, for embedders that go on to build
+	// their own executor.Executor (see Manager.Registry). If nil, a
+	// registry rooted at Config.RegistryDir is constructed, using
+	// HTTPClient if set.
+	Registry *registry.Registry
+
+	// Hooks lets the embedder observe a Manager's progress instead of
+	// relying on stdout. It does not cover interactive prompts or checksum
+	// verification - those happen in the CLI layer and internal/executor
+	// respectively, outside any single Manager call.
+	Hooks Hooks
+}
+
+// NewManager creates a Manager from Options, applying the same defaults
+// install.New(cfg) does for any field left unset. It is the embeddable
+// counterpart to New: downstream programs and test suites that want to
+// inject a logger, HTTP client, or hooks should use NewManager instead of
+// New.
+func NewManager(opts Options) (*Manager, error) {
+	cfg := opts.Config
+	if cfg == nil {
+		c, err := config.DefaultConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default config: %w", err)
+		}
+		cfg = c
+	}
+
+	mgr := New(cfg)
+
+	if opts.Store != nil {
+		mgr.state = opts.Store
+	}
+	if opts.Registry != nil {
+		mgr.registry = opts.Registry
+	} else if opts.HTTPClient != nil {
+		mgr.registry = registry.NewWithClient(cfg.RegistryDir, opts.HTTPClient)
+	}
+	if opts.Logger != nil {
+		mgr.logger = opts.Logger
+	}
+	mgr.hooks = opts.Hooks
+
+	return mgr, nil
+}
+
+// logProgress reports a progress message via Hooks.OnProgress if set,
+// otherwise preserves the CLI's historical behavior of printing to stdout.
+func (m *Manager) logProgress(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if m.hooks.OnProgress != nil {
+		m.hooks.OnProgress(message)
+		return
+	}
+	fmt.Println(message)
+}
+
+// log returns the Manager's configured Logger, falling back to log.Default()
+// for Managers constructed via New rather than NewManager.
+func (m *Manager) log() log.Logger {
+	if m.logger != nil {
+		return m.logger
+	}
+	return log.Default()
+}
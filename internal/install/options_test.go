@@ -0,0 +1,89 @@
+package install
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/tsukumogami/tsuku/internal/testutil"
+)
+
+func TestNewManager_DefaultsMatchNew(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr, err := NewManager(Options{Config: cfg})
+	if err != nil {
+		t.Fatalf("NewManager() error: %v", err)
+	}
+
+	if mgr.config != cfg {
+		t.Errorf("Config not applied: got %v, want %v", mgr.config, cfg)
+	}
+	if mgr.registry != nil {
+		t.Errorf("Registry should be nil when Options.Registry and HTTPClient are unset, got %v", mgr.registry)
+	}
+}
+
+func TestNewManager_CustomStoreAndHooks(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	store := NewStateManager(cfg)
+	var progressMessages []string
+
+	mgr, err := NewManager(Options{
+		Config: cfg,
+		Store:  store,
+		Hooks: Hooks{
+			OnProgress: func(msg string) {
+				progressMessages = append(progressMessages, msg)
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewManager() error: %v", err)
+	}
+	if mgr.state != store {
+		t.Errorf("Store not applied: got %v, want %v", mgr.state, store)
+	}
+
+	if err := os.MkdirAll(cfg.ToolDir("ripgrep", "14.1.0")+"/.install/bin", 0755); err != nil {
+		t.Fatalf("failed to set up work dir: %v", err)
+	}
+	workDir := cfg.ToolDir("ripgrep", "14.1.0")
+	if err := os.WriteFile(workDir+"/.install/bin/rg", []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatalf("failed to write fake binary: %v", err)
+	}
+
+	opts := DefaultInstallOptions()
+	opts.Binaries = []string{"bin/rg"}
+	if err := mgr.InstallWithOptions("ripgrep", "14.1.0", workDir, opts); err != nil {
+		t.Fatalf("InstallWithOptions() error: %v", err)
+	}
+
+	if len(progressMessages) == 0 {
+		t.Error("expected OnProgress hook to be called, got no messages")
+	}
+}
+
+func TestInstallWithOptions_ErrorIsExecError(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+
+	// No .install directory under this workDir, so copying fails.
+	err := mgr.InstallWithOptions("missing-tool", "1.0.0", cfg.HomeDir, DefaultInstallOptions())
+	if err == nil {
+		t.Fatal("expected an error for a missing work directory")
+	}
+
+	var execErr *ExecError
+	if !errors.As(err, &execErr) {
+		t.Fatalf("expected error to be an *ExecError, got %T: %v", err, err)
+	}
+	if execErr.Op != "install" || execErr.Tool != "missing-tool" || execErr.Version != "1.0.0" {
+		t.Errorf("unexpected ExecError fields: %+v", execErr)
+	}
+}
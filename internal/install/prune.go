@@ -0,0 +1,259 @@
+package install
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/tsukumogami/tsuku/internal/semverutil"
+)
+
+// PruneSelector describes which installed tool versions to remove. The
+// selectors compose: Keep acts as a protective floor (the newest Keep
+// versions of a tool are never removed, even if they also match OlderThan
+// or UnusedSince), while OlderThan, UnusedSince, Exact, and SemverRange each
+// contribute candidates to the removal set. A version matching none of
+// OlderThan, UnusedSince, Exact, or SemverRange is never removed - except
+// that Keep alone (no other selector set) acts as its own selector: it picks
+// the N+1'th-and-older installed versions of every tool for removal, rather
+// than only protecting candidates found some other way. The currently active
+// version of a tool is always protected, since removing it would break the
+// current/ symlink.
+type PruneSelector struct {
+	// Exact selects specific "tool@version" entries, e.g. "ripgrep@14.1.0".
+	Exact []string
+
+	// SemverRange selects "tool@constraint" entries, e.g. "ripgrep@~14.0",
+	// where constraint is parsed with github.com/Masterminds/semver/v3.
+	SemverRange []string
+
+	// Keep retains the Keep most-recently-installed versions of every tool
+	// touched by this prune, regardless of whether they'd otherwise match.
+	// Zero means no protective floor beyond the active version.
+	Keep int
+
+	// OlderThan selects versions installed more than this duration ago.
+	// Zero disables this criterion.
+	OlderThan time.Duration
+
+	// UnusedSince selects versions whose LastUsedAt (or InstalledAt, if the
+	// version was never activated) is older than this duration. Zero
+	// disables this criterion.
+	UnusedSince time.Duration
+
+	// DryRun computes and returns the removal set without deleting anything.
+	DryRun bool
+}
+
+// PruneDecision records what happened (or would happen, under DryRun) to a
+// single installed tool version.
+type PruneDecision struct {
+	Tool    string `json:"tool"`
+	Version string `json:"version"`
+	Path    string `json:"path"`
+	Bytes   int64  `json:"bytes"`
+	Reason  string `json:"reason"` // "exact", "semver-range", "older-than", "unused-since", "keep"
+}
+
+// PruneResult is the outcome of a Prune call.
+type PruneResult struct {
+	Removed        []PruneDecision `json:"removed"`
+	Skipped        []PruneDecision `json:"skipped,omitempty"` // matched a criterion but protected (active version or Keep floor)
+	BytesReclaimed int64           `json:"bytes_reclaimed"`
+}
+
+// Prune removes installed tool versions matching selector, returning the
+// per-version decisions so callers (CLI or programmatic) can report exactly
+// what was removed and how many bytes were reclaimed. With selector.DryRun
+// set, Prune computes the same decisions but does not touch disk or state.
+func (m *Manager) Prune(ctx context.Context, selector PruneSelector) (PruneResult, error) {
+	state, err := m.state.Load()
+	if err != nil {
+		return PruneResult{}, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	exactTargets, err := parseExactTargets(selector.Exact)
+	if err != nil {
+		return PruneResult{}, err
+	}
+	rangeTargets, err := parseRangeTargets(selector.SemverRange)
+	if err != nil {
+		return PruneResult{}, err
+	}
+
+	now := timeNow()
+	var result PruneResult
+
+	// When Keep is the only selector set, it doesn't just protect candidates
+	// found by another criterion - it becomes the criterion, selecting every
+	// version past the newest Keep for removal.
+	keepOnly := selector.Keep > 0 && len(exactTargets) == 0 && len(rangeTargets) == 0 &&
+		selector.OlderThan == 0 && selector.UnusedSince == 0
+
+	for toolName, toolState := range state.Installed {
+		if err := ctx.Err(); err != nil {
+			return PruneResult{}, err
+		}
+
+		// Determine the install-order ranking so Keep can protect the
+		// newest versions; ties broken by version string for determinism.
+		versions := make([]string, 0, len(toolState.Versions))
+		for v := range toolState.Versions {
+			versions = append(versions, v)
+		}
+		sort.Slice(versions, func(i, j int) bool {
+			vi, vj := toolState.Versions[versions[i]], toolState.Versions[versions[j]]
+			if !vi.InstalledAt.Equal(vj.InstalledAt) {
+				return vi.InstalledAt.After(vj.InstalledAt)
+			}
+			return versions[i] < versions[j]
+		})
+		kept := make(map[string]bool, selector.Keep)
+		for i := 0; i < selector.Keep && i < len(versions); i++ {
+			kept[versions[i]] = true
+		}
+
+		for rank, version := range versions {
+			vs := toolState.Versions[version]
+
+			reason := matchReason(toolName, version, vs, exactTargets, rangeTargets, selector, now, rank, keepOnly)
+			if reason == "" {
+				continue
+			}
+
+			toolDir := m.config.ToolDir(toolName, version)
+			size, sizeErr := dirSize(toolDir)
+			if sizeErr != nil && !os.IsNotExist(sizeErr) {
+				return PruneResult{}, fmt.Errorf("failed to measure %s@%s: %w", toolName, version, sizeErr)
+			}
+
+			decision := PruneDecision{Tool: toolName, Version: version, Path: toolDir, Bytes: size, Reason: reason}
+
+			if version == toolState.ActiveVersion || kept[version] {
+				result.Skipped = append(result.Skipped, decision)
+				continue
+			}
+
+			if !selector.DryRun {
+				if err := os.RemoveAll(toolDir); err != nil {
+					return PruneResult{}, fmt.Errorf("failed to remove %s: %w", toolDir, err)
+				}
+				if err := m.state.UpdateTool(toolName, func(ts *ToolState) {
+					delete(ts.Versions, version)
+				}); err != nil {
+					return PruneResult{}, fmt.Errorf("failed to update state after removing %s@%s: %w", toolName, version, err)
+				}
+			}
+
+			result.Removed = append(result.Removed, decision)
+			result.BytesReclaimed += size
+		}
+	}
+
+	sort.Slice(result.Removed, func(i, j int) bool { return decisionLess(result.Removed[i], result.Removed[j]) })
+	sort.Slice(result.Skipped, func(i, j int) bool { return decisionLess(result.Skipped[i], result.Skipped[j]) })
+
+	return result, nil
+}
+
+func decisionLess(a, b PruneDecision) bool {
+	if a.Tool != b.Tool {
+		return a.Tool < b.Tool
+	}
+	return a.Version < b.Version
+}
+
+// matchReason returns the first selector criterion that makes version a
+// removal candidate, or "" if none apply. rank is version's 0-indexed
+// position among its tool's versions ordered newest-first, used only for the
+// standalone-Keep case (see keepOnly).
+func matchReason(tool, version string, vs VersionState, exact map[string]string, ranges map[string][]*semver.Constraints, selector PruneSelector, now time.Time, rank int, keepOnly bool) string {
+	if exact[tool] == version {
+		return "exact"
+	}
+	if constraints, ok := ranges[tool]; ok {
+		if detected, err := semverutil.CoerceVersion(version); err == nil {
+			for _, c := range constraints {
+				if c.Check(detected) {
+					return "semver-range"
+				}
+			}
+		}
+	}
+	if selector.OlderThan > 0 && !vs.InstalledAt.IsZero() && now.Sub(vs.InstalledAt) > selector.OlderThan {
+		return "older-than"
+	}
+	if selector.UnusedSince > 0 {
+		lastUsed := vs.LastUsedAt
+		if lastUsed.IsZero() {
+			lastUsed = vs.InstalledAt
+		}
+		if !lastUsed.IsZero() && now.Sub(lastUsed) > selector.UnusedSince {
+			return "unused-since"
+		}
+	}
+	if keepOnly && rank >= selector.Keep {
+		return "keep"
+	}
+	return ""
+}
+
+// parseExactTargets parses "tool@version" entries into a tool -> version map.
+func parseExactTargets(entries []string) (map[string]string, error) {
+	targets := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		tool, version, err := splitToolSelector(entry)
+		if err != nil {
+			return nil, err
+		}
+		targets[tool] = version
+	}
+	return targets, nil
+}
+
+// parseRangeTargets parses "tool@constraint" entries into a tool -> parsed
+// semver constraints map.
+func parseRangeTargets(entries []string) (map[string][]*semver.Constraints, error) {
+	targets := make(map[string][]*semver.Constraints, len(entries))
+	for _, entry := range entries {
+		tool, constraintExpr, err := splitToolSelector(entry)
+		if err != nil {
+			return nil, err
+		}
+		constraint, err := semver.NewConstraint(constraintExpr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semver range %q: %w", entry, err)
+		}
+		targets[tool] = append(targets[tool], constraint)
+	}
+	return targets, nil
+}
+
+// splitToolSelector splits a "tool@selector" string into its two parts.
+func splitToolSelector(entry string) (tool, selector string, err error) {
+	idx := strings.Index(entry, "@")
+	if idx <= 0 || idx == len(entry)-1 {
+		return "", "", fmt.Errorf("invalid selector %q: expected \"tool@version\" or \"tool@constraint\"", entry)
+	}
+	return entry[:idx], entry[idx+1:], nil
+}
+
+// dirSize returns the total size in bytes of all regular files under dir.
+func dirSize(dir string) (int64, error) {
+	var total int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total, err
+}
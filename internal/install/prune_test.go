@@ -0,0 +1,238 @@
+package install
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/tsukumogami/tsuku/internal/testutil"
+)
+
+func TestPrune_OlderThan(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+	sm := NewStateManager(cfg)
+
+	for _, v := range []string{"1.0.0", "2.0.0"} {
+		if err := os.MkdirAll(cfg.ToolDir("ripgrep", v), 0755); err != nil {
+			t.Fatalf("failed to create tool dir: %v", err)
+		}
+	}
+
+	err := sm.UpdateTool("ripgrep", func(ts *ToolState) {
+		ts.ActiveVersion = "2.0.0"
+		ts.Versions = map[string]VersionState{
+			"1.0.0": {InstalledAt: time.Now().Add(-100 * 24 * time.Hour)},
+			"2.0.0": {InstalledAt: time.Now()},
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to set up state: %v", err)
+	}
+
+	result, err := mgr.Prune(context.Background(), PruneSelector{OlderThan: 90 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if len(result.Removed) != 1 || result.Removed[0].Version != "1.0.0" {
+		t.Fatalf("Removed = %+v, want [1.0.0]", result.Removed)
+	}
+	if _, err := os.Stat(cfg.ToolDir("ripgrep", "1.0.0")); !os.IsNotExist(err) {
+		t.Error("expected 1.0.0 directory to be removed")
+	}
+	if _, err := os.Stat(cfg.ToolDir("ripgrep", "2.0.0")); err != nil {
+		t.Error("expected 2.0.0 directory to remain")
+	}
+}
+
+func TestPrune_ActiveVersionProtected(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+	sm := NewStateManager(cfg)
+
+	if err := os.MkdirAll(cfg.ToolDir("ripgrep", "1.0.0"), 0755); err != nil {
+		t.Fatalf("failed to create tool dir: %v", err)
+	}
+
+	err := sm.UpdateTool("ripgrep", func(ts *ToolState) {
+		ts.ActiveVersion = "1.0.0"
+		ts.Versions = map[string]VersionState{
+			"1.0.0": {InstalledAt: time.Now().Add(-100 * 24 * time.Hour)},
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to set up state: %v", err)
+	}
+
+	result, err := mgr.Prune(context.Background(), PruneSelector{OlderThan: 90 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if len(result.Removed) != 0 {
+		t.Errorf("Removed = %+v, want none (active version protected)", result.Removed)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].Version != "1.0.0" {
+		t.Errorf("Skipped = %+v, want [1.0.0]", result.Skipped)
+	}
+}
+
+func TestPrune_KeepProtectsNewest(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+	sm := NewStateManager(cfg)
+
+	versions := []string{"1.0.0", "2.0.0", "3.0.0"}
+	for _, v := range versions {
+		if err := os.MkdirAll(cfg.ToolDir("ripgrep", v), 0755); err != nil {
+			t.Fatalf("failed to create tool dir: %v", err)
+		}
+	}
+
+	err := sm.UpdateTool("ripgrep", func(ts *ToolState) {
+		ts.ActiveVersion = "3.0.0"
+		ts.Versions = map[string]VersionState{
+			"1.0.0": {InstalledAt: time.Now().Add(-3 * time.Hour)},
+			"2.0.0": {InstalledAt: time.Now().Add(-2 * time.Hour)},
+			"3.0.0": {InstalledAt: time.Now().Add(-1 * time.Hour)},
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to set up state: %v", err)
+	}
+
+	// OlderThan matches all three; Keep=2 should protect the two newest.
+	result, err := mgr.Prune(context.Background(), PruneSelector{OlderThan: time.Minute, Keep: 2})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if len(result.Removed) != 1 || result.Removed[0].Version != "1.0.0" {
+		t.Fatalf("Removed = %+v, want [1.0.0]", result.Removed)
+	}
+}
+
+func TestPrune_KeepAloneSelectsOlderVersions(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+	sm := NewStateManager(cfg)
+
+	versions := []string{"1.0.0", "2.0.0", "3.0.0"}
+	for _, v := range versions {
+		if err := os.MkdirAll(cfg.ToolDir("ripgrep", v), 0755); err != nil {
+			t.Fatalf("failed to create tool dir: %v", err)
+		}
+	}
+
+	err := sm.UpdateTool("ripgrep", func(ts *ToolState) {
+		ts.ActiveVersion = "3.0.0"
+		ts.Versions = map[string]VersionState{
+			"1.0.0": {InstalledAt: time.Now().Add(-3 * time.Hour)},
+			"2.0.0": {InstalledAt: time.Now().Add(-2 * time.Hour)},
+			"3.0.0": {InstalledAt: time.Now().Add(-1 * time.Hour)},
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to set up state: %v", err)
+	}
+
+	// Keep=2 alone, with no other selector set, should select the 1 version
+	// older than the 2 newest for removal rather than matching nothing.
+	result, err := mgr.Prune(context.Background(), PruneSelector{Keep: 2})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if len(result.Removed) != 1 || result.Removed[0].Version != "1.0.0" {
+		t.Fatalf("Removed = %+v, want [1.0.0]", result.Removed)
+	}
+	if result.Removed[0].Reason != "keep" {
+		t.Errorf("Reason = %q, want %q", result.Removed[0].Reason, "keep")
+	}
+}
+
+func TestPrune_Exact(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+	sm := NewStateManager(cfg)
+
+	for _, v := range []string{"1.0.0", "2.0.0"} {
+		if err := os.MkdirAll(cfg.ToolDir("ripgrep", v), 0755); err != nil {
+			t.Fatalf("failed to create tool dir: %v", err)
+		}
+	}
+
+	err := sm.UpdateTool("ripgrep", func(ts *ToolState) {
+		ts.ActiveVersion = "2.0.0"
+		ts.Versions = map[string]VersionState{
+			"1.0.0": {},
+			"2.0.0": {},
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to set up state: %v", err)
+	}
+
+	result, err := mgr.Prune(context.Background(), PruneSelector{Exact: []string{"ripgrep@1.0.0"}})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if len(result.Removed) != 1 || result.Removed[0].Version != "1.0.0" || result.Removed[0].Reason != "exact" {
+		t.Fatalf("Removed = %+v, want [1.0.0 (exact)]", result.Removed)
+	}
+}
+
+func TestPrune_DryRunLeavesDiskAndStateUntouched(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr := New(cfg)
+	sm := NewStateManager(cfg)
+
+	if err := os.MkdirAll(cfg.ToolDir("ripgrep", "1.0.0"), 0755); err != nil {
+		t.Fatalf("failed to create tool dir: %v", err)
+	}
+
+	err := sm.UpdateTool("ripgrep", func(ts *ToolState) {
+		ts.ActiveVersion = "2.0.0" // different version active, so 1.0.0 is eligible
+		ts.Versions = map[string]VersionState{
+			"1.0.0": {InstalledAt: time.Now().Add(-100 * 24 * time.Hour)},
+		}
+	})
+	if err != nil {
+		t.Fatalf("failed to set up state: %v", err)
+	}
+
+	result, err := mgr.Prune(context.Background(), PruneSelector{OlderThan: 90 * 24 * time.Hour, DryRun: true})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+
+	if len(result.Removed) != 1 {
+		t.Fatalf("Removed = %+v, want 1 entry reported", result.Removed)
+	}
+	if _, err := os.Stat(cfg.ToolDir("ripgrep", "1.0.0")); err != nil {
+		t.Error("dry run should not remove the directory")
+	}
+
+	toolState, err := sm.GetToolState("ripgrep")
+	if err != nil {
+		t.Fatalf("GetToolState() error = %v", err)
+	}
+	if _, exists := toolState.Versions["1.0.0"]; !exists {
+		t.Error("dry run should not remove the version from state")
+	}
+}
@@ -6,7 +6,13 @@ import (
 )
 
 // Remove removes an installed tool
-func (m *Manager) Remove(name string) error {
+func (m *Manager) Remove(name string) (err error) {
+	defer func() {
+		if err != nil {
+			err = &ExecError{Op: "remove", Tool: name, Err: err}
+		}
+	}()
+
 	// 1. Find installed version
 	tools, err := m.List()
 	if err != nil {
@@ -39,5 +45,7 @@ func (m *Manager) Remove(name string) error {
 		}
 	}
 
+	m.log().Info("removed tool", "tool", name, "version", version)
+
 	return nil
 }
@@ -18,6 +18,12 @@ type VersionState struct {
 	Binaries    []string  `json:"binaries,omitempty"` // Binary names this version provides
 	InstalledAt time.Time `json:"installed_at"`       // When this version was installed
 	Plan        *Plan     `json:"plan,omitempty"`     // Installation plan (if generated)
+
+	// LastUsedAt tracks the last time this version was made active via
+	// Activate, as a proxy for "unused since" pruning decisions. It is not
+	// updated on every invocation of the tool's binaries, since plain
+	// symlinked binaries run without going through tsuku at all.
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
 }
 
 // Plan represents a stored installation plan. This is a simplified view of
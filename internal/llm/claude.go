@@ -68,7 +68,10 @@ func (p *ClaudeProvider) Complete(ctx context.Context, req *CompletionRequest) (
 		return nil, fmt.Errorf("anthropic API call failed: %w", err)
 	}
 
-	return fromAnthropicResponse(resp), nil
+	result := fromAnthropicResponse(resp)
+	result.Usage.Provider = p.Name()
+	result.Usage.Model = string(p.model)
+	return result, nil
 }
 
 // toAnthropicMessages converts common Messages to Anthropic format.
@@ -1,31 +1,47 @@
 // Package llm provides a client for interacting with Claude for recipe generation.
 package llm
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 // Usage tracks token consumption across LLM API calls.
 type Usage struct {
-	InputTokens  int
-	OutputTokens int
+	Provider         string // Provider that served the request (e.g., "claude", "gemini")
+	Model            string // Model identifier used for pricing lookup
+	InputTokens      int
+	OutputTokens     int
+	CacheReadTokens  int // Tokens served from a prompt cache (usually discounted)
+	CacheWriteTokens int // Tokens written to a prompt cache (usually surcharged)
 }
 
-// Pricing constants for Claude Sonnet 4 (per 1M tokens in USD).
-const (
-	inputPricePerMillion  = 3.0  // $3 per 1M input tokens
-	outputPricePerMillion = 15.0 // $15 per 1M output tokens
-)
-
 // Add accumulates usage from another Usage into this one.
+// Provider and Model are copied from other if not already set, on the
+// assumption that a single accumulator tracks one provider/model pair
+// across conversation turns.
 func (u *Usage) Add(other Usage) {
+	if u.Provider == "" {
+		u.Provider = other.Provider
+	}
+	if u.Model == "" {
+		u.Model = other.Model
+	}
 	u.InputTokens += other.InputTokens
 	u.OutputTokens += other.OutputTokens
+	u.CacheReadTokens += other.CacheReadTokens
+	u.CacheWriteTokens += other.CacheWriteTokens
 }
 
-// Cost returns the estimated cost in USD based on Claude Sonnet 4 pricing.
+// Cost returns the estimated cost in USD, using the pricing registered for
+// (Provider, Model) via RegisterPricing, or the built-in default if no
+// entry matches.
 func (u Usage) Cost() float64 {
-	inputCost := float64(u.InputTokens) * inputPricePerMillion / 1_000_000
-	outputCost := float64(u.OutputTokens) * outputPricePerMillion / 1_000_000
-	return inputCost + outputCost
+	p := LookupPricing(u.Provider, u.Model)
+	return float64(u.InputTokens)*p.InputPerMillion/1_000_000 +
+		float64(u.OutputTokens)*p.OutputPerMillion/1_000_000 +
+		float64(u.CacheReadTokens)*p.CacheReadPerMillion/1_000_000 +
+		float64(u.CacheWriteTokens)*p.CacheWritePerMillion/1_000_000
 }
 
 // String returns a human-readable summary of token usage and cost.
@@ -33,3 +49,80 @@ func (u Usage) String() string {
 	return fmt.Sprintf("tokens: %d in / %d out, cost: $%.4f",
 		u.InputTokens, u.OutputTokens, u.Cost())
 }
+
+// Pricing holds per-1M-token rates in USD for a single provider/model.
+type Pricing struct {
+	InputPerMillion      float64
+	OutputPerMillion     float64
+	CacheReadPerMillion  float64 // Cached/prefix-cache reads, typically discounted
+	CacheWritePerMillion float64 // Cache writes, typically surcharged
+}
+
+// defaultPricing is used when no entry matches (provider, model) and no
+// provider-level default is registered either. It mirrors the original
+// Claude Sonnet 4 rates this package shipped with before pricing became
+// pluggable, so existing callers see no behavior change.
+var defaultPricing = Pricing{
+	InputPerMillion:  3.0,
+	OutputPerMillion: 15.0,
+}
+
+var (
+	pricingMu    sync.RWMutex
+	pricingTable = map[string]map[string]Pricing{}
+)
+
+// RegisterPricing registers the rate card for a (provider, model) pair.
+// Passing an empty model registers a provider-level default used when a
+// specific model isn't found for that provider. Call sites (including
+// third-party builds and tests) use this to inject or override rates.
+func RegisterPricing(provider, model string, p Pricing) {
+	pricingMu.Lock()
+	defer pricingMu.Unlock()
+	if pricingTable[provider] == nil {
+		pricingTable[provider] = map[string]Pricing{}
+	}
+	pricingTable[provider][model] = p
+}
+
+// LookupPricing returns the pricing for (provider, model), falling back to
+// the provider's registered default (model == ""), then to the package
+// default if neither is registered.
+func LookupPricing(provider, model string) Pricing {
+	pricingMu.RLock()
+	defer pricingMu.RUnlock()
+
+	models, ok := pricingTable[provider]
+	if !ok {
+		return defaultPricing
+	}
+	if p, ok := models[model]; ok {
+		return p
+	}
+	if p, ok := models[""]; ok {
+		return p
+	}
+	return defaultPricing
+}
+
+func init() {
+	// Built-in rate cards, in USD per 1M tokens. These are list prices at
+	// time of writing and are expected to drift; callers that need current
+	// numbers should override via RegisterPricing (e.g. from config or env).
+	RegisterPricing("claude", "claude-sonnet-4-5-20250929", Pricing{
+		InputPerMillion:      3.0,
+		OutputPerMillion:     15.0,
+		CacheReadPerMillion:  0.3,
+		CacheWritePerMillion: 3.75,
+	})
+	RegisterPricing("gemini", "gemini-2.0-flash", Pricing{
+		InputPerMillion:  0.1,
+		OutputPerMillion: 0.4,
+	})
+	RegisterPricing("gpt", "gpt-4o", Pricing{
+		InputPerMillion:  2.5,
+		OutputPerMillion: 10.0,
+	})
+	// Local (on-device) inference has no per-token cost.
+	RegisterPricing("local", "", Pricing{})
+}
@@ -79,3 +79,64 @@ func TestUsage_String(t *testing.T) {
 		t.Errorf("Usage.String() = %q, want %q", got, want)
 	}
 }
+
+func TestUsage_Cost_UsesRegisteredProviderModel(t *testing.T) {
+	RegisterPricing("test-provider", "test-model", Pricing{
+		InputPerMillion:  1.0,
+		OutputPerMillion: 2.0,
+	})
+
+	u := Usage{Provider: "test-provider", Model: "test-model", InputTokens: 1_000_000, OutputTokens: 1_000_000}
+	if got, want := u.Cost(), 3.0; got != want {
+		t.Errorf("Usage.Cost() = %v, want %v", got, want)
+	}
+}
+
+func TestUsage_Cost_FallsBackToProviderDefault(t *testing.T) {
+	RegisterPricing("test-fallback", "", Pricing{InputPerMillion: 5.0, OutputPerMillion: 0})
+
+	u := Usage{Provider: "test-fallback", Model: "unregistered-model", InputTokens: 1_000_000}
+	if got, want := u.Cost(), 5.0; got != want {
+		t.Errorf("Usage.Cost() = %v, want %v", got, want)
+	}
+}
+
+func TestUsage_Cost_UnknownProviderFallsBackToDefault(t *testing.T) {
+	u := Usage{Provider: "totally-unknown-provider", InputTokens: 1_000_000}
+	if got, want := u.Cost(), defaultPricing.InputPerMillion; got != want {
+		t.Errorf("Usage.Cost() = %v, want %v", got, want)
+	}
+}
+
+func TestUsage_Cost_IncludesCacheTokens(t *testing.T) {
+	RegisterPricing("test-cache", "test-model", Pricing{
+		CacheReadPerMillion:  1.0,
+		CacheWritePerMillion: 2.0,
+	})
+
+	u := Usage{Provider: "test-cache", Model: "test-model", CacheReadTokens: 1_000_000, CacheWriteTokens: 1_000_000}
+	if got, want := u.Cost(), 3.0; got != want {
+		t.Errorf("Usage.Cost() = %v, want %v", got, want)
+	}
+}
+
+func TestUsage_Add_PropagatesProviderAndModel(t *testing.T) {
+	var total Usage
+	total.Add(Usage{Provider: "claude", Model: "claude-sonnet-4-5-20250929", InputTokens: 10})
+
+	if total.Provider != "claude" || total.Model != "claude-sonnet-4-5-20250929" {
+		t.Errorf("Add() did not propagate Provider/Model, got %+v", total)
+	}
+}
+
+func TestLookupPricing_BuiltInEntries(t *testing.T) {
+	p := LookupPricing("local", "")
+	if p.InputPerMillion != 0 || p.OutputPerMillion != 0 {
+		t.Errorf("LookupPricing(local) = %+v, want zero cost", p)
+	}
+
+	p = LookupPricing("claude", "claude-sonnet-4-5-20250929")
+	if p.InputPerMillion != 3.0 || p.OutputPerMillion != 15.0 {
+		t.Errorf("LookupPricing(claude) = %+v, want 3.0/15.0", p)
+	}
+}
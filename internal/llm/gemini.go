@@ -237,6 +237,8 @@ func (p *GeminiProvider) convertResponse(resp *genai.GenerateContentResponse) *C
 	// Extract token usage
 	if resp.UsageMetadata != nil {
 		result.Usage = Usage{
+			Provider:     p.Name(),
+			Model:        p.model,
 			InputTokens:  int(resp.UsageMetadata.PromptTokenCount),
 			OutputTokens: int(resp.UsageMetadata.CandidatesTokenCount),
 		}
@@ -163,7 +163,9 @@ func (p *LocalProvider) sendRequest(ctx context.Context, req *CompletionRequest)
 		return nil, fmt.Errorf("local LLM completion failed: %w", err)
 	}
 
-	return fromProtoResponse(pbResp), nil
+	result := fromProtoResponse(pbResp)
+	result.Usage.Provider = p.Name()
+	return result, nil
 }
 
 // ensureConnection establishes the gRPC connection if not already connected.
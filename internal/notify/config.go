@@ -0,0 +1,57 @@
+package notify
+
+import "time"
+
+// IgnoreConfig suppresses noisy events before they reach an Endpoint.
+// It mirrors the ignore block in Docker distribution's notifications
+// config: events matching either list are dropped, not just throttled.
+type IgnoreConfig struct {
+	// Actions lists step action names (e.g. "chmod") whose StepStarted/
+	// StepCompleted/StepFailed events should not be delivered.
+	Actions []string `toml:"actions,omitempty"`
+
+	// Mediatypes lists Event.MediaType values (or prefixes thereof) to
+	// suppress, e.g. "application/vnd.tsuku.StepStarted+json".
+	Mediatypes []string `toml:"mediatypes,omitempty"`
+}
+
+// Endpoint describes one external receiver of installation events.
+type Endpoint struct {
+	URL     string            `toml:"url"`
+	Headers map[string]string `toml:"headers,omitempty"`
+	Timeout time.Duration     `toml:"timeout,omitempty"`
+
+	// Threshold is the minimum Severity delivered to this endpoint:
+	// "fatal", "error", "warn", or "info" (the default).
+	Threshold string `toml:"threshold,omitempty"`
+
+	Ignore IgnoreConfig `toml:"ignore,omitempty"`
+}
+
+// severity returns the endpoint's configured Threshold as a Severity,
+// defaulting to SeverityInfo (deliver everything) when unset.
+func (e Endpoint) severity() Severity {
+	if e.Threshold == "" {
+		return SeverityInfo
+	}
+	return Severity(e.Threshold)
+}
+
+// accepts reports whether ev should be delivered to e: it must meet the
+// endpoint's Threshold and must not match either Ignore list.
+func (e Endpoint) accepts(ev Event) bool {
+	if !meetsThreshold(ev.Severity, e.severity()) {
+		return false
+	}
+	for _, action := range e.Ignore.Actions {
+		if action == ev.Action {
+			return false
+		}
+	}
+	for _, mt := range e.Ignore.Mediatypes {
+		if mt == ev.MediaType {
+			return false
+		}
+	}
+	return true
+}
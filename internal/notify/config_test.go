@@ -0,0 +1,51 @@
+package notify
+
+import "testing"
+
+func TestEndpoint_AcceptsThreshold(t *testing.T) {
+	ep := Endpoint{Threshold: "error"}
+
+	infoEvent := Event{Severity: SeverityInfo}
+	if ep.accepts(infoEvent) {
+		t.Errorf("accepts() = true for info event with error threshold, want false")
+	}
+
+	errorEvent := Event{Severity: SeverityError}
+	if !ep.accepts(errorEvent) {
+		t.Errorf("accepts() = false for error event with error threshold, want true")
+	}
+
+	fatalEvent := Event{Severity: SeverityFatal}
+	if !ep.accepts(fatalEvent) {
+		t.Errorf("accepts() = false for fatal event with error threshold, want true")
+	}
+}
+
+func TestEndpoint_AcceptsDefaultThreshold(t *testing.T) {
+	ep := Endpoint{}
+	if !ep.accepts(Event{Severity: SeverityInfo}) {
+		t.Errorf("accepts() = false for info event with no threshold, want true (default admits everything)")
+	}
+}
+
+func TestEndpoint_IgnoreActions(t *testing.T) {
+	ep := Endpoint{Ignore: IgnoreConfig{Actions: []string{"chmod"}}}
+
+	if ep.accepts(Event{Severity: SeverityInfo, Action: "chmod"}) {
+		t.Errorf("accepts() = true for ignored action, want false")
+	}
+	if !ep.accepts(Event{Severity: SeverityInfo, Action: "download"}) {
+		t.Errorf("accepts() = false for non-ignored action, want true")
+	}
+}
+
+func TestEndpoint_IgnoreMediatypes(t *testing.T) {
+	ep := Endpoint{Ignore: IgnoreConfig{Mediatypes: []string{mediaType(StepStarted)}}}
+
+	if ep.accepts(Event{Severity: SeverityInfo, MediaType: mediaType(StepStarted)}) {
+		t.Errorf("accepts() = true for ignored media type, want false")
+	}
+	if !ep.accepts(Event{Severity: SeverityInfo, MediaType: mediaType(StepCompleted)}) {
+		t.Errorf("accepts() = false for non-ignored media type, want true")
+	}
+}
@@ -0,0 +1,277 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/tsukumogami/tsuku/internal/httputil"
+	"github.com/tsukumogami/tsuku/internal/log"
+)
+
+// queueCapacity bounds the number of events buffered in memory before a
+// slow or unreachable endpoint starts forcing events into the disk spool.
+const queueCapacity = 256
+
+// maxDeliveryAttempts caps the exponential-backoff retry loop for a single
+// spooled event before it's left on disk for a future process to retry.
+const maxDeliveryAttempts = 5
+
+// Dispatcher delivers events to configured endpoints and in-process sinks.
+// Delivery to HTTP endpoints runs in a background goroutine with a bounded
+// queue and exponential-backoff retry; undelivered events are written to a
+// disk-backed spool so they survive a crash and are retried by the next
+// Dispatcher that starts against the same spool directory.
+type Dispatcher struct {
+	endpoints []Endpoint
+	sinks     []EventSink
+	client    *http.Client
+	spoolDir  string
+	logger    log.Logger
+
+	queue chan Event
+	wg    sync.WaitGroup
+	stop  chan struct{}
+}
+
+// NewDispatcher creates a Dispatcher for endpoints, delivering a copy of
+// every accepted event to each in-process sink as well. spoolDir may be
+// empty to disable the disk-backed spool (useful in tests).
+func NewDispatcher(endpoints []Endpoint, spoolDir string, sinks ...EventSink) *Dispatcher {
+	return &Dispatcher{
+		endpoints: endpoints,
+		sinks:     sinks,
+		client:    httputil.NewSecureClient(httputil.DefaultOptions()),
+		spoolDir:  spoolDir,
+		logger:    log.Default(),
+		queue:     make(chan Event, queueCapacity),
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start launches the background delivery goroutine, which first drains any
+// events left in the spool directory by a previous, crashed process. Start
+// itself returns immediately; spool draining (with its per-event retry
+// backoff against possibly-unreachable endpoints) never blocks the caller.
+func (d *Dispatcher) Start() {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.drainSpool()
+		for {
+			select {
+			case ev := <-d.queue:
+				d.deliver(ev)
+			case <-d.stop:
+				// Drain whatever is already queued before exiting.
+				for {
+					select {
+					case ev := <-d.queue:
+						d.deliver(ev)
+					default:
+						return
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Stop drains the queue and waits for in-flight deliveries to finish.
+func (d *Dispatcher) Stop() {
+	close(d.stop)
+	d.wg.Wait()
+}
+
+// Emit hands ev to every in-process sink synchronously, then enqueues it
+// for asynchronous HTTP delivery. If the in-memory queue is full, ev is
+// written straight to the spool instead of blocking the caller.
+func (d *Dispatcher) Emit(ev Event) {
+	for _, sink := range d.sinks {
+		if err := sink.Emit(ev); err != nil {
+			d.logger.Warn("notify: sink failed to emit event", "type", ev.Type, "error", err)
+		}
+	}
+
+	if len(d.endpoints) == 0 {
+		return
+	}
+
+	select {
+	case d.queue <- ev:
+	default:
+		d.spool(ev)
+	}
+}
+
+// deliver attempts to send ev to every endpoint that accepts it, spooling
+// it for retry on failure.
+func (d *Dispatcher) deliver(ev Event) {
+	delivered := true
+	for _, ep := range d.endpoints {
+		if !ep.accepts(ev) {
+			continue
+		}
+		if err := d.send(ep, ev); err != nil {
+			d.logger.Warn("notify: delivery failed", "url", ep.URL, "error", err)
+			delivered = false
+		}
+	}
+	if !delivered {
+		d.spool(ev)
+	}
+}
+
+// send performs a single HTTP POST of ev to ep.
+func (d *Dispatcher) send(ep Endpoint, ev Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	timeout := ep.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range ep.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// spool persists ev to spoolDir so it survives a crash and can be retried
+// by drainSpool on the next Dispatcher to start against this directory.
+func (d *Dispatcher) spool(ev Event) {
+	if d.spoolDir == "" {
+		return
+	}
+	if err := os.MkdirAll(d.spoolDir, 0755); err != nil {
+		d.logger.Warn("notify: failed to create spool dir", "error", err)
+		return
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		d.logger.Warn("notify: failed to marshal spooled event", "error", err)
+		return
+	}
+
+	name := spoolFileName(ev, data)
+	path := filepath.Join(d.spoolDir, name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		d.logger.Warn("notify: failed to write spool file", "error", err)
+	}
+}
+
+// spoolFileName derives a unique, deterministic file name for a spooled
+// event from its content, so redelivering the same event twice overwrites
+// rather than duplicates the spool entry.
+func spoolFileName(ev Event, data []byte) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("%s-%s.json", ev.Type, hex.EncodeToString(sum[:8]))
+}
+
+// drainSpool retries every event currently on disk with exponential
+// backoff, removing each file once delivery succeeds (or once
+// maxDeliveryAttempts is exhausted, to avoid retrying a permanently
+// unreachable endpoint forever). It abandons the remaining backlog as soon
+// as d.stop fires, so a caller's Stop() isn't held up by a full retry cycle
+// against an unreachable endpoint; anything left on disk is picked up by
+// the next Dispatcher to start against this spool directory.
+func (d *Dispatcher) drainSpool() {
+	if d.spoolDir == "" {
+		return
+	}
+	entries, err := os.ReadDir(d.spoolDir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		select {
+		case <-d.stop:
+			return
+		default:
+		}
+
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(d.spoolDir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var ev Event
+		if err := json.Unmarshal(data, &ev); err != nil {
+			continue
+		}
+
+		if d.redeliverWithBackoff(ev) {
+			os.Remove(path)
+		}
+	}
+}
+
+// redeliverWithBackoff retries ev against every accepting endpoint, backing
+// off exponentially between attempts, until it succeeds everywhere,
+// maxDeliveryAttempts is reached, or d.stop fires.
+func (d *Dispatcher) redeliverWithBackoff(ev Event) bool {
+	backoff := 100 * time.Millisecond
+	for attempt := 0; attempt < maxDeliveryAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-d.stop:
+				return false
+			}
+			backoff *= 2
+		}
+
+		allDelivered := true
+		for _, ep := range d.endpoints {
+			if !ep.accepts(ev) {
+				continue
+			}
+			if err := d.send(ep, ev); err != nil {
+				allDelivered = false
+			}
+		}
+		if allDelivered {
+			return true
+		}
+
+		select {
+		case <-d.stop:
+			return false
+		default:
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+// Package notify delivers installation lifecycle events to external
+// observers (CI dashboards, Slack bots, audit logs) so they can watch
+// `tsuku install` runs without polling tsuku's own state.
+package notify
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/tsukumogami/tsuku/internal/buildinfo"
+)
+
+// EventType identifies the kind of lifecycle event being reported.
+type EventType string
+
+const (
+	// StepStarted fires immediately before a plan step executes.
+	StepStarted EventType = "StepStarted"
+
+	// StepCompleted fires after a plan step executes successfully.
+	StepCompleted EventType = "StepCompleted"
+
+	// StepFailed fires when a plan step returns an error.
+	StepFailed EventType = "StepFailed"
+
+	// PlanCompleted fires once after every step in a plan has run.
+	PlanCompleted EventType = "PlanCompleted"
+
+	// RecipeFetched fires when a recipe is resolved from the registry.
+	RecipeFetched EventType = "RecipeFetched"
+)
+
+// Severity classifies how important an event is, so endpoints can filter
+// by their configured Threshold.
+type Severity string
+
+const (
+	SeverityFatal Severity = "fatal"
+	SeverityError Severity = "error"
+	SeverityWarn  Severity = "warn"
+	SeverityInfo  Severity = "info"
+)
+
+// severityRank orders severities from least to most important, so a
+// Threshold of "warn" also admits "error" and "fatal".
+var severityRank = map[Severity]int{
+	SeverityInfo:  0,
+	SeverityWarn:  1,
+	SeverityError: 2,
+	SeverityFatal: 3,
+}
+
+// meetsThreshold reports whether sev is at or above threshold.
+// An empty threshold admits everything.
+func meetsThreshold(sev, threshold Severity) bool {
+	if threshold == "" {
+		return true
+	}
+	return severityRank[sev] >= severityRank[threshold]
+}
+
+// defaultSeverity returns the severity a bare event type implies absent a
+// more specific signal (e.g. a non-zero Error on the envelope).
+func defaultSeverity(t EventType) Severity {
+	if t == StepFailed {
+		return SeverityError
+	}
+	return SeverityInfo
+}
+
+// Event is the JSON envelope delivered to notification endpoints and
+// written to FileSink spools. It deliberately mirrors the information
+// ExecutePlan already prints to stdout, so sinks observe the same facts
+// a human watching the CLI would see.
+type Event struct {
+	Type EventType `json:"type"`
+
+	// MediaType lets an endpoint's ignore.mediatypes filter match this
+	// event without parsing Type, mirroring Docker distribution's
+	// notifications config.
+	MediaType string `json:"media_type"`
+
+	Tool          string `json:"tool"`
+	RecipeVersion string `json:"recipe_version,omitempty"`
+
+	// Step/Action are populated for StepStarted/StepCompleted/StepFailed.
+	Step   int    `json:"step,omitempty"`
+	Action string `json:"action,omitempty"`
+
+	DurationMs int64  `json:"duration_ms,omitempty"`
+	OS         string `json:"os"`
+	Arch       string `json:"arch"`
+
+	// StderrTail holds a redacted tail of stderr for StepFailed events.
+	StderrTail string `json:"stderr_tail,omitempty"`
+
+	TsukuVersion string    `json:"tsuku_version"`
+	Timestamp    time.Time `json:"timestamp"`
+
+	Severity Severity `json:"severity"`
+}
+
+// mediaType builds the "application/vnd.tsuku.<type>+json" media type used
+// for ignore.mediatypes matching.
+func mediaType(t EventType) string {
+	return "application/vnd.tsuku." + string(t) + "+json"
+}
+
+// newEvent creates an Event with the common envelope fields pre-filled.
+func newEvent(t EventType, tool, recipeVersion string) Event {
+	return Event{
+		Type:          t,
+		MediaType:     mediaType(t),
+		Tool:          tool,
+		RecipeVersion: recipeVersion,
+		OS:            runtime.GOOS,
+		Arch:          runtime.GOARCH,
+		TsukuVersion:  buildinfo.Version(),
+		Timestamp:     time.Now().UTC(),
+		Severity:      defaultSeverity(t),
+	}
+}
+
+// NewStepStartedEvent reports that step (1-indexed, matching ExecutePlan's
+// own numbering) is about to run.
+func NewStepStartedEvent(tool, recipeVersion string, step int, action string) Event {
+	e := newEvent(StepStarted, tool, recipeVersion)
+	e.Step = step
+	e.Action = action
+	return e
+}
+
+// NewStepCompletedEvent reports that step finished successfully.
+func NewStepCompletedEvent(tool, recipeVersion string, step int, action string, duration time.Duration) Event {
+	e := newEvent(StepCompleted, tool, recipeVersion)
+	e.Step = step
+	e.Action = action
+	e.DurationMs = duration.Milliseconds()
+	return e
+}
+
+// NewStepFailedEvent reports that step returned an error. stderrTail should
+// already be trimmed to a reasonable size by the caller; RedactTail further
+// masks anything that looks like a secret before it leaves the process.
+func NewStepFailedEvent(tool, recipeVersion string, step int, action string, duration time.Duration, stderrTail string) Event {
+	e := newEvent(StepFailed, tool, recipeVersion)
+	e.Step = step
+	e.Action = action
+	e.DurationMs = duration.Milliseconds()
+	e.StderrTail = RedactTail(stderrTail)
+	return e
+}
+
+// NewPlanCompletedEvent reports that every step in a plan has run.
+func NewPlanCompletedEvent(tool, recipeVersion string, duration time.Duration) Event {
+	e := newEvent(PlanCompleted, tool, recipeVersion)
+	e.DurationMs = duration.Milliseconds()
+	return e
+}
+
+// NewRecipeFetchedEvent reports that a recipe was resolved from the registry.
+func NewRecipeFetchedEvent(tool, recipeVersion string) Event {
+	return newEvent(RecipeFetched, tool, recipeVersion)
+}
@@ -0,0 +1,32 @@
+package notify
+
+import (
+	"regexp"
+	"strings"
+)
+
+// maxStderrTailBytes bounds how much stderr an event can carry, so a noisy
+// failure doesn't blow up endpoint payloads or the disk spool.
+const maxStderrTailBytes = 4096
+
+// secretPatterns matches common secret shapes that might appear in a
+// failing command's stderr (API keys, bearer tokens, basic auth, key=value
+// assignments for credential-shaped keys).
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(Bearer|Basic)\s+[A-Za-z0-9._\-+/=]{8,}`),
+	regexp.MustCompile(`(?i)sk-[A-Za-z0-9]{16,}`),
+	regexp.MustCompile(`(?i)\b(token|secret|password|api[_-]?key)\s*[:=]\s*\S+`),
+}
+
+// RedactTail trims s to the last maxStderrTailBytes and masks substrings
+// that look like secrets, so StepFailed events can't leak credentials into
+// external notification endpoints or the on-disk spool.
+func RedactTail(s string) string {
+	if len(s) > maxStderrTailBytes {
+		s = s[len(s)-maxStderrTailBytes:]
+	}
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "[REDACTED]")
+	}
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactTail_MasksSecrets(t *testing.T) {
+	input := "connecting with token: abc123verysecret\nBearer eyJhbGciOiJI.somejwt.body\nrequest failed"
+	got := RedactTail(input)
+
+	if strings.Contains(got, "abc123verysecret") {
+		t.Errorf("RedactTail() did not mask token value: %q", got)
+	}
+	if strings.Contains(got, "eyJhbGciOiJI.somejwt.body") {
+		t.Errorf("RedactTail() did not mask bearer token: %q", got)
+	}
+	if !strings.Contains(got, "request failed") {
+		t.Errorf("RedactTail() dropped unrelated content: %q", got)
+	}
+}
+
+func TestRedactTail_TruncatesLongInput(t *testing.T) {
+	input := strings.Repeat("x", maxStderrTailBytes+100)
+	got := RedactTail(input)
+
+	if len(got) > maxStderrTailBytes {
+		t.Errorf("RedactTail() length = %d, want <= %d", len(got), maxStderrTailBytes)
+	}
+}
@@ -0,0 +1,71 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// EventSink receives events synchronously. It lets tests (and callers that
+// don't want an HTTP round trip) observe the event stream in-process,
+// without spinning up a server.
+type EventSink interface {
+	Emit(Event) error
+}
+
+// SinkFunc adapts a plain function to an EventSink.
+type SinkFunc func(Event) error
+
+// Emit implements EventSink.
+func (f SinkFunc) Emit(e Event) error { return f(e) }
+
+// FileSink appends NDJSON-encoded events to a local file, for audit logs
+// that don't need a remote endpoint.
+type FileSink struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+}
+
+// NewFileSink opens (creating if needed) path for appending and returns a
+// FileSink that writes one JSON object per line to it.
+func NewFileSink(path string) (*FileSink, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory for file sink: %w", err)
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file sink %s: %w", path, err)
+	}
+
+	return &FileSink{path: path, f: f}, nil
+}
+
+// Emit appends e as a single NDJSON line.
+func (s *FileSink) Emit(e Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	data = append(data, '\n')
+
+	if _, err := s.f.Write(data); err != nil {
+		return fmt.Errorf("failed to write event to %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
@@ -0,0 +1,65 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSink_EmitAppendsNDJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	defer sink.Close()
+
+	events := []Event{
+		NewStepStartedEvent("ripgrep", "14.1.0", 1, "download"),
+		NewStepCompletedEvent("ripgrep", "14.1.0", 1, "download", 0),
+	}
+	for _, e := range events {
+		if err := sink.Emit(e); err != nil {
+			t.Fatalf("Emit() error = %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open spool file: %v", err)
+	}
+	defer f.Close()
+
+	var lines int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev Event
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			t.Fatalf("failed to unmarshal line %d: %v", lines, err)
+		}
+		lines++
+	}
+
+	if lines != len(events) {
+		t.Errorf("wrote %d lines, want %d", lines, len(events))
+	}
+}
+
+func TestSinkFunc_Emit(t *testing.T) {
+	var got Event
+	sink := SinkFunc(func(e Event) error {
+		got = e
+		return nil
+	})
+
+	want := NewRecipeFetchedEvent("ripgrep", "14.1.0")
+	if err := sink.Emit(want); err != nil {
+		t.Fatalf("Emit() error = %v", err)
+	}
+	if got.Type != RecipeFetched || got.Tool != "ripgrep" {
+		t.Errorf("Emit() did not call underlying function with event, got %+v", got)
+	}
+}
@@ -0,0 +1,103 @@
+package plansign
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// keyFileName is the filename of tsuku's own ed25519 signing key within
+// KeysDir.
+const keyFileName = "tsuku.key"
+
+// KeysDir returns the directory tsuku stores its signing keypair in:
+// $XDG_CONFIG_HOME/tsuku/keys, falling back to ~/.config/tsuku/keys when
+// XDG_CONFIG_HOME isn't set. This is deliberately separate from
+// config.Config.HomeDir (~/.tsuku): a signing key is host/user
+// configuration, not installation state, the same distinction XDG draws
+// between config and data directories.
+func KeysDir() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "tsuku", "keys"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "tsuku", "keys"), nil
+}
+
+// Ed25519Signer signs plan digests with a local ed25519 keypair, presenting
+// as a fixed identity.
+type Ed25519Signer struct {
+	identity string
+	private  ed25519.PrivateKey
+	public   ed25519.PublicKey
+}
+
+// LoadOrCreateSigner loads the ed25519 keypair at <KeysDir>/tsuku.key,
+// generating one on first use, and returns a Signer that presents as
+// identity when it signs. identity is caller-supplied (e.g. "tsuku-official"
+// for a CI signing service, or a developer's own name) rather than derived
+// from the key, since the same keypair may sign under different identities
+// in different trust policies.
+func LoadOrCreateSigner(identity string) (*Ed25519Signer, error) {
+	dir, err := KeysDir()
+	if err != nil {
+		return nil, err
+	}
+	keyPath := filepath.Join(dir, keyFileName)
+
+	data, err := os.ReadFile(keyPath)
+	switch {
+	case err == nil:
+		if len(data) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("key file %s is not a valid ed25519 private key", keyPath)
+		}
+		priv := ed25519.PrivateKey(data)
+		return &Ed25519Signer{identity: identity, private: priv, public: priv.Public().(ed25519.PublicKey)}, nil
+	case os.IsNotExist(err):
+		// fall through to generate a new keypair
+	default:
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create keys directory: %w", err)
+	}
+	if err := os.WriteFile(keyPath, priv, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write key file: %w", err)
+	}
+
+	return &Ed25519Signer{identity: identity, private: priv, public: pub}, nil
+}
+
+// Identity returns the identity this signer presents as.
+func (s *Ed25519Signer) Identity() string {
+	return s.identity
+}
+
+// PublicKeyHex returns the hex-encoded public key, for publishing into a
+// trust.toml [signers.<identity>] entry.
+func (s *Ed25519Signer) PublicKeyHex() string {
+	return hex.EncodeToString(s.public)
+}
+
+// Sign signs data, returning a Signature carrying this signer's identity
+// and public key alongside the raw ed25519 signature bytes.
+func (s *Ed25519Signer) Sign(data []byte) (Signature, error) {
+	return Signature{
+		Algorithm: "ed25519",
+		Identity:  s.identity,
+		KeyID:     s.PublicKeyHex(),
+		Value:     base64.StdEncoding.EncodeToString(ed25519.Sign(s.private, data)),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package plansign
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadOrCreateSigner_GeneratesAndPersists(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	signer, err := LoadOrCreateSigner("tsuku-official")
+	if err != nil {
+		t.Fatalf("LoadOrCreateSigner() error: %v", err)
+	}
+	if signer.Identity() != "tsuku-official" {
+		t.Errorf("Identity() = %q, want %q", signer.Identity(), "tsuku-official")
+	}
+
+	again, err := LoadOrCreateSigner("tsuku-official")
+	if err != nil {
+		t.Fatalf("second LoadOrCreateSigner() error: %v", err)
+	}
+	if again.PublicKeyHex() != signer.PublicKeyHex() {
+		t.Error("LoadOrCreateSigner() generated a new key instead of reusing the persisted one")
+	}
+}
+
+func TestLoadOrCreateSigner_DifferentIdentitySameKey(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	a, err := LoadOrCreateSigner("alice")
+	if err != nil {
+		t.Fatalf("LoadOrCreateSigner() error: %v", err)
+	}
+	b, err := LoadOrCreateSigner("bob")
+	if err != nil {
+		t.Fatalf("LoadOrCreateSigner() error: %v", err)
+	}
+	if a.PublicKeyHex() != b.PublicKeyHex() {
+		t.Error("identities sharing a host key should share the same public key")
+	}
+	if a.Identity() == b.Identity() {
+		t.Error("Identity() should reflect the caller-supplied identity, not the key")
+	}
+}
+
+func TestKeysDir_UsesXDGConfigHome(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	got, err := KeysDir()
+	if err != nil {
+		t.Fatalf("KeysDir() error: %v", err)
+	}
+	want := filepath.Join(dir, "tsuku", "keys")
+	if got != want {
+		t.Errorf("KeysDir() = %q, want %q", got, want)
+	}
+}
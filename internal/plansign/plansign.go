@@ -0,0 +1,77 @@
+// Package plansign signs and verifies tsuku installation plans with
+// ed25519 keys, so that a plan executed from a file (rather than resolved
+// live from a recipe) can be traced back to who produced it. This
+// complements executor.InstallationPlan.RecipeHash: the hash pins what
+// recipe content the plan came from, the signature pins who resolved it.
+package plansign
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// Signature is a detached signature over a plan's canonical bytes
+// (see executor.PlanDigest). KeyID is the hex-encoded public key that
+// produced Value, included so Verify can catch a mismatched or stale
+// trust-store entry before it ever calls ed25519.Verify.
+type Signature struct {
+	Algorithm string `json:"algorithm"` // currently always "ed25519"
+	Identity  string `json:"identity"`  // signer identity, matched against TrustPolicy
+	KeyID     string `json:"key_id"`    // hex-encoded ed25519 public key
+	Value     string `json:"signature"` // base64-encoded raw signature bytes
+}
+
+// Signer produces a detached signature over arbitrary bytes, presenting as
+// a fixed identity. Ed25519Signer is the only implementation today; the
+// interface exists so a future Sigstore keyless signer can slot in
+// alongside it without changing Sign's callers.
+type Signer interface {
+	Identity() string
+	Sign(data []byte) (Signature, error)
+}
+
+// Sign produces a Signature over data using signer.
+func Sign(data []byte, signer Signer) (*Signature, error) {
+	sig, err := signer.Sign(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+	return &sig, nil
+}
+
+// Verify checks that sig is a valid ed25519 signature over data from a
+// signer pinned in trust, and that trust permits that signer's identity to
+// sign for source (an executor.InstallationPlan.RecipeSource value, e.g.
+// "registry" or a local file path). It returns the verified identity.
+func Verify(data []byte, sig *Signature, source string, trust *TrustPolicy) (string, error) {
+	if sig == nil {
+		return "", fmt.Errorf("plan is not signed")
+	}
+	if sig.Algorithm != "ed25519" {
+		return "", fmt.Errorf("unsupported signature algorithm %q", sig.Algorithm)
+	}
+
+	pub, ok := trust.PublicKey(sig.Identity)
+	if !ok {
+		return "", fmt.Errorf("%q is not a trusted signer identity", sig.Identity)
+	}
+	if hex.EncodeToString(pub) != sig.KeyID {
+		return "", fmt.Errorf("signature key_id does not match the public key trusted for %q", sig.Identity)
+	}
+
+	sigBytes, err := base64.StdEncoding.DecodeString(sig.Value)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if !ed25519.Verify(pub, data, sigBytes) {
+		return "", fmt.Errorf("signature does not verify for identity %q", sig.Identity)
+	}
+
+	if !trust.Allows(source, sig.Identity) {
+		return "", fmt.Errorf("%q is not an allowed signer for source %q", sig.Identity, source)
+	}
+
+	return sig.Identity, nil
+}
@@ -0,0 +1,98 @@
+package plansign
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+)
+
+// testSigner wraps a freshly generated ed25519 keypair for tests that don't
+// want to touch KeysDir.
+func testSigner(t *testing.T, identity string) (*Ed25519Signer, ed25519.PublicKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate test key: %v", err)
+	}
+	return &Ed25519Signer{identity: identity, private: priv, public: pub}, pub
+}
+
+func trustFor(identity string, pub ed25519.PublicKey, source string, allowed ...string) *TrustPolicy {
+	return &TrustPolicy{
+		Signers: map[string]SignerEntry{identity: {PublicKey: hex.EncodeToString(pub)}},
+		Sources: map[string]SourcePolicy{source: {RequireSignature: true, AllowedSigners: allowed}},
+	}
+}
+
+func TestSignAndVerify_RoundTrip(t *testing.T) {
+	signer, pub := testSigner(t, "tsuku-official")
+	data := []byte("plan bytes")
+
+	sig, err := Sign(data, signer)
+	if err != nil {
+		t.Fatalf("Sign() error: %v", err)
+	}
+
+	trust := trustFor("tsuku-official", pub, "registry", "tsuku-official")
+	identity, err := Verify(data, sig, "registry", trust)
+	if err != nil {
+		t.Fatalf("Verify() error: %v", err)
+	}
+	if identity != "tsuku-official" {
+		t.Errorf("identity = %q, want %q", identity, "tsuku-official")
+	}
+}
+
+func TestVerify_RejectsTamperedData(t *testing.T) {
+	signer, pub := testSigner(t, "tsuku-official")
+	sig, err := Sign([]byte("original"), signer)
+	if err != nil {
+		t.Fatalf("Sign() error: %v", err)
+	}
+
+	trust := trustFor("tsuku-official", pub, "registry", "tsuku-official")
+	if _, err := Verify([]byte("tampered"), sig, "registry", trust); err == nil {
+		t.Error("Verify() succeeded for tampered data, want error")
+	}
+}
+
+func TestVerify_RejectsUntrustedIdentity(t *testing.T) {
+	signer, _ := testSigner(t, "mallory")
+	data := []byte("plan bytes")
+	sig, err := Sign(data, signer)
+	if err != nil {
+		t.Fatalf("Sign() error: %v", err)
+	}
+
+	// trust only knows about "tsuku-official", not "mallory"
+	_, otherPub := testSigner(t, "tsuku-official")
+	trust := trustFor("tsuku-official", otherPub, "registry", "tsuku-official")
+
+	if _, err := Verify(data, sig, "registry", trust); err == nil {
+		t.Error("Verify() succeeded for an identity absent from trust, want error")
+	}
+}
+
+func TestVerify_RejectsDisallowedSourceSigner(t *testing.T) {
+	signer, pub := testSigner(t, "community-builder")
+	data := []byte("plan bytes")
+	sig, err := Sign(data, signer)
+	if err != nil {
+		t.Fatalf("Sign() error: %v", err)
+	}
+
+	// community-builder is a known, valid signer, but not allowed for "registry"
+	trust := trustFor("community-builder", pub, "registry", "tsuku-official")
+
+	if _, err := Verify(data, sig, "registry", trust); err == nil {
+		t.Error("Verify() succeeded for a signer not allowed on this source, want error")
+	}
+}
+
+func TestVerify_NilSignature(t *testing.T) {
+	trust := &TrustPolicy{}
+	if _, err := Verify([]byte("x"), nil, "registry", trust); err == nil {
+		t.Error("Verify() succeeded for a nil signature, want error")
+	}
+}
@@ -0,0 +1,49 @@
+package plansign
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// SignatureFileSuffix is appended to a plan file's path to form its
+// detached signature file, e.g. "plan.json" -> "plan.json.sig".
+const SignatureFileSuffix = ".sig"
+
+// SignatureFilePath returns the detached signature path for planPath.
+func SignatureFilePath(planPath string) string {
+	return planPath + SignatureFileSuffix
+}
+
+// WriteSignatureFile marshals sig as JSON and writes it to planPath's
+// signature file.
+func WriteSignatureFile(planPath string, sig *Signature) error {
+	data, err := json.MarshalIndent(sig, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal signature: %w", err)
+	}
+	if err := os.WriteFile(SignatureFilePath(planPath), data, 0644); err != nil {
+		return fmt.Errorf("failed to write signature file: %w", err)
+	}
+	return nil
+}
+
+// ReadSignatureFile loads the detached signature for planPath, if one
+// exists. It returns (nil, nil) when no .sig file is present, since an
+// unsigned plan is a valid (if possibly policy-rejected) state rather than
+// an error.
+func ReadSignatureFile(planPath string) (*Signature, error) {
+	data, err := os.ReadFile(SignatureFilePath(planPath))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature file: %w", err)
+	}
+
+	var sig Signature
+	if err := json.Unmarshal(data, &sig); err != nil {
+		return nil, fmt.Errorf("failed to parse signature file: %w", err)
+	}
+	return &sig, nil
+}
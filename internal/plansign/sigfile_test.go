@@ -0,0 +1,43 @@
+package plansign
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteAndReadSignatureFile_RoundTrip(t *testing.T) {
+	planPath := filepath.Join(t.TempDir(), "plan.json")
+	sig := &Signature{Algorithm: "ed25519", Identity: "tsuku-official", KeyID: "aabbcc", Value: "deadbeef"}
+
+	if err := WriteSignatureFile(planPath, sig); err != nil {
+		t.Fatalf("WriteSignatureFile() error: %v", err)
+	}
+
+	got, err := ReadSignatureFile(planPath)
+	if err != nil {
+		t.Fatalf("ReadSignatureFile() error: %v", err)
+	}
+	if got == nil || *got != *sig {
+		t.Errorf("ReadSignatureFile() = %+v, want %+v", got, sig)
+	}
+}
+
+func TestReadSignatureFile_MissingFileReturnsNil(t *testing.T) {
+	planPath := filepath.Join(t.TempDir(), "plan.json")
+
+	sig, err := ReadSignatureFile(planPath)
+	if err != nil {
+		t.Fatalf("ReadSignatureFile() error: %v", err)
+	}
+	if sig != nil {
+		t.Errorf("ReadSignatureFile() = %+v, want nil for a missing .sig file", sig)
+	}
+}
+
+func TestSignatureFilePath(t *testing.T) {
+	got := SignatureFilePath("plan.json")
+	want := "plan.json.sig"
+	if got != want {
+		t.Errorf("SignatureFilePath() = %q, want %q", got, want)
+	}
+}
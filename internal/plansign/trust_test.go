@@ -0,0 +1,62 @@
+package plansign
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadTrustPolicy_MissingFileReturnsEmptyPolicy(t *testing.T) {
+	policy, err := LoadTrustPolicy(filepath.Join(t.TempDir(), "trust.toml"))
+	if err != nil {
+		t.Fatalf("LoadTrustPolicy() error: %v", err)
+	}
+	if policy.RequiresSignature("registry") {
+		t.Error("empty policy should not require a signature")
+	}
+}
+
+func TestLoadTrustPolicy_ParsesSignersAndSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trust.toml")
+	contents := `
+[signers.tsuku-official]
+public_key = "aabbcc"
+
+[sources.registry]
+require_signature = true
+allowed_signers = ["tsuku-official"]
+`
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("failed to write trust.toml: %v", err)
+	}
+
+	policy, err := LoadTrustPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadTrustPolicy() error: %v", err)
+	}
+	if !policy.RequiresSignature("registry") {
+		t.Error("RequiresSignature(\"registry\") = false, want true")
+	}
+	if policy.RequiresSignature("local") {
+		t.Error("RequiresSignature(\"local\") = true, want false")
+	}
+	if !policy.Allows("registry", "tsuku-official") {
+		t.Error("Allows(\"registry\", \"tsuku-official\") = false, want true")
+	}
+	if policy.Allows("registry", "mallory") {
+		t.Error("Allows(\"registry\", \"mallory\") = true, want false")
+	}
+}
+
+func TestTrustPolicy_NilReceiverIsSafe(t *testing.T) {
+	var policy *TrustPolicy
+	if policy.RequiresSignature("registry") {
+		t.Error("nil policy should not require a signature")
+	}
+	if !policy.Allows("registry", "anyone") {
+		t.Error("nil policy should allow any identity")
+	}
+	if _, ok := policy.PublicKey("anyone"); ok {
+		t.Error("nil policy should have no pinned public keys")
+	}
+}
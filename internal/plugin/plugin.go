@@ -0,0 +1,196 @@
+// Package plugin discovers out-of-process action plugins and wires them
+// into the action registry, so tsuku can gain new actions without a
+// recompile. The model mirrors Helm's plugin system: a plugin is a
+// directory containing a plugin.toml manifest and an executable that
+// speaks a small JSON request/response protocol over stdin/stdout.
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/tsukumogami/tsuku/internal/actions"
+)
+
+// Manifest is the contents of a plugin's plugin.toml.
+type Manifest struct {
+	Name       string           `toml:"name"`
+	Version    string           `toml:"version"`
+	Executable string           `toml:"executable"` // path to the plugin binary, relative to the plugin directory
+	Actions    []ActionManifest `toml:"actions"`
+}
+
+// ActionManifest describes a single action a plugin provides.
+type ActionManifest struct {
+	Name string `toml:"name"`
+
+	// Evaluable marks the action as deterministically reproducible, the same
+	// distinction built-in primitive actions make (see actions.IsPrimitive).
+	Evaluable bool `toml:"evaluable"`
+
+	// ParamSchema is a JSON schema describing the action's params, for
+	// documentation and `tsuku plugin list` output. It is not enforced.
+	ParamSchema string `toml:"param_schema,omitempty"`
+}
+
+// Plugin is a discovered plugin: its manifest plus the directory it was
+// loaded from.
+type Plugin struct {
+	Manifest Manifest
+	Dir      string
+}
+
+// ExecutablePath returns the absolute path to the plugin's executable.
+func (p *Plugin) ExecutablePath() string {
+	return filepath.Join(p.Dir, p.Manifest.Executable)
+}
+
+// Discover scans dir for plugin subdirectories, each containing a
+// plugin.toml manifest. A missing dir is not an error: plugins are
+// optional. A subdirectory with a malformed or missing manifest is skipped
+// with a warning rather than failing discovery for every other plugin.
+func Discover(dir string) ([]*Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("plugin: failed to read plugins directory %s: %w", dir, err)
+	}
+
+	var plugins []*Plugin
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		pluginDir := filepath.Join(dir, entry.Name())
+		manifestPath := filepath.Join(pluginDir, "plugin.toml")
+
+		var manifest Manifest
+		if _, err := toml.DecodeFile(manifestPath, &manifest); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping plugin %q: %v\n", entry.Name(), err)
+			continue
+		}
+
+		plugins = append(plugins, &Plugin{Manifest: manifest, Dir: pluginDir})
+	}
+
+	return plugins, nil
+}
+
+// DiscoverAndRegister discovers plugins under dir and registers each of
+// their actions with the global action registry, extending the primitive
+// set so evaluable plugin-provided actions pass executor.ValidatePlan the
+// same way built-in primitives do. Called once at startup by install.New;
+// a dir that doesn't exist or a plugin that fails to load is not fatal.
+//
+// Plugin actions marked non-evaluable in the manifest are registered but
+// not marked primitive; decomposing them into primitives the way built-in
+// composite actions do would require a second plugin protocol call this
+// package doesn't yet implement, so such actions can currently only be used
+// where a non-primitive action is acceptable (not inside a generated plan).
+func DiscoverAndRegister(dir string) []*Plugin {
+	plugins, err := Discover(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: plugin discovery failed: %v\n", err)
+		return nil
+	}
+
+	for _, p := range plugins {
+		for _, am := range p.Manifest.Actions {
+			actions.Register(newAction(p, am))
+			if am.Evaluable {
+				actions.RegisterPrimitive(am.Name)
+			}
+		}
+	}
+
+	return plugins
+}
+
+// request is the JSON payload sent to a plugin executable on stdin.
+type request struct {
+	Action  string                 `json:"action"`
+	Params  map[string]interface{} `json:"params"`
+	WorkDir string                 `json:"workdir"`
+	Env     []string               `json:"env"`
+	Tool    string                 `json:"tool"`
+	Version string                 `json:"version"`
+}
+
+// response is the JSON payload a plugin executable writes to stdout.
+type response struct {
+	Success       bool     `json:"success"`
+	Message       string   `json:"message"`
+	ProducedFiles []string `json:"produced_files"`
+	EnvMutations  []string `json:"env_mutations"`
+}
+
+// action adapts a plugin-provided action to the actions.Action interface by
+// shelling out to the plugin's executable and speaking its JSON protocol.
+type action struct {
+	plugin   *Plugin
+	manifest ActionManifest
+}
+
+func newAction(p *Plugin, am ActionManifest) *action {
+	return &action{plugin: p, manifest: am}
+}
+
+func (a *action) Name() string {
+	return a.manifest.Name
+}
+
+func (a *action) Execute(ctx *actions.ExecutionContext, params map[string]interface{}) error {
+	var tool string
+	if ctx.Recipe != nil {
+		tool = ctx.Recipe.Metadata.Name
+	}
+
+	reqBody, err := json.Marshal(request{
+		Action:  a.manifest.Name,
+		Params:  params,
+		WorkDir: ctx.WorkDir,
+		Env:     ctx.Env,
+		Tool:    tool,
+		Version: ctx.Version,
+	})
+	if err != nil {
+		return fmt.Errorf("plugin %s: failed to marshal request for %q: %w", a.plugin.Manifest.Name, a.manifest.Name, err)
+	}
+
+	cmd := exec.CommandContext(ctx.Context, a.plugin.ExecutablePath())
+	cmd.Dir = ctx.WorkDir
+	cmd.Stdin = bytes.NewReader(reqBody)
+	cmd.Stderr = os.Stderr
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("plugin %s: action %q failed: %w", a.plugin.Manifest.Name, a.manifest.Name, err)
+	}
+
+	var resp response
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return fmt.Errorf("plugin %s: invalid response from action %q: %w", a.plugin.Manifest.Name, a.manifest.Name, err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("plugin %s: action %q reported failure: %s", a.plugin.Manifest.Name, a.manifest.Name, resp.Message)
+	}
+
+	ctx.Env = append(ctx.Env, resp.EnvMutations...)
+	return nil
+}
+
+func (a *action) IsDeterministic() bool {
+	return a.manifest.Evaluable
+}
+
+func (a *action) Dependencies() actions.ActionDeps {
+	return actions.ActionDeps{}
+}
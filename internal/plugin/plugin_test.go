@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/tsukumogami/tsuku/internal/actions"
+)
+
+func TestDiscover_MissingDir(t *testing.T) {
+	plugins, err := Discover(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plugins != nil {
+		t.Errorf("expected nil plugins for missing dir, got %+v", plugins)
+	}
+}
+
+func TestDiscover_SkipsMalformedManifest(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "bad", "not valid toml {{{")
+
+	plugins, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plugins) != 0 {
+		t.Errorf("expected malformed plugin to be skipped, got %+v", plugins)
+	}
+}
+
+func TestDiscover_LoadsManifest(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "demo", `
+name = "demo"
+version = "1.0.0"
+executable = "demo.sh"
+
+[[actions]]
+name = "demo_action"
+evaluable = true
+`)
+
+	plugins, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plugins) != 1 {
+		t.Fatalf("expected 1 plugin, got %d", len(plugins))
+	}
+	p := plugins[0]
+	if p.Manifest.Name != "demo" || p.Manifest.Version != "1.0.0" {
+		t.Errorf("unexpected manifest: %+v", p.Manifest)
+	}
+	if len(p.Manifest.Actions) != 1 || p.Manifest.Actions[0].Name != "demo_action" || !p.Manifest.Actions[0].Evaluable {
+		t.Errorf("unexpected actions: %+v", p.Manifest.Actions)
+	}
+	wantPath := filepath.Join(dir, "demo", "demo.sh")
+	if got := p.ExecutablePath(); got != wantPath {
+		t.Errorf("ExecutablePath() = %q, want %q", got, wantPath)
+	}
+}
+
+func TestDiscoverAndRegister_RegistersEvaluableActionsAsPrimitives(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "demo", `
+name = "demo"
+version = "1.0.0"
+executable = "demo.sh"
+
+[[actions]]
+name = "plugin_test_evaluable_action"
+evaluable = true
+
+[[actions]]
+name = "plugin_test_nonevaluable_action"
+evaluable = false
+`)
+
+	DiscoverAndRegister(dir)
+
+	if actions.Get("plugin_test_evaluable_action") == nil {
+		t.Error("expected evaluable action to be registered")
+	}
+	if !actions.IsPrimitive("plugin_test_evaluable_action") {
+		t.Error("expected evaluable action to be registered as a primitive")
+	}
+	if actions.Get("plugin_test_nonevaluable_action") == nil {
+		t.Error("expected non-evaluable action to still be registered")
+	}
+	if actions.IsPrimitive("plugin_test_nonevaluable_action") {
+		t.Error("expected non-evaluable action not to be a primitive")
+	}
+}
+
+func TestAction_ExecuteRoundTrip(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test script is a POSIX shell script")
+	}
+
+	dir := t.TempDir()
+	script := `#!/bin/sh
+read req
+echo '{"success": true, "message": "ok", "env_mutations": ["FOO=bar"]}'
+`
+	scriptPath := filepath.Join(dir, "demo.sh")
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write script: %v", err)
+	}
+
+	p := &Plugin{
+		Manifest: Manifest{Name: "demo", Version: "1.0.0", Executable: "demo.sh"},
+		Dir:      dir,
+	}
+	act := newAction(p, ActionManifest{Name: "demo_action", Evaluable: true})
+
+	ctx := &actions.ExecutionContext{Context: context.Background(), WorkDir: t.TempDir()}
+	if err := act.Execute(ctx, map[string]interface{}{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ctx.Env) != 1 || ctx.Env[0] != "FOO=bar" {
+		t.Errorf("expected env mutation to be applied, got %+v", ctx.Env)
+	}
+}
+
+func TestAction_ExecuteFailureResponse(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test script is a POSIX shell script")
+	}
+
+	dir := t.TempDir()
+	script := `#!/bin/sh
+read req
+echo '{"success": false, "message": "boom"}'
+`
+	scriptPath := filepath.Join(dir, "demo.sh")
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write script: %v", err)
+	}
+
+	p := &Plugin{
+		Manifest: Manifest{Name: "demo", Version: "1.0.0", Executable: "demo.sh"},
+		Dir:      dir,
+	}
+	act := newAction(p, ActionManifest{Name: "demo_action"})
+
+	ctx := &actions.ExecutionContext{Context: context.Background(), WorkDir: t.TempDir()}
+	if err := act.Execute(ctx, map[string]interface{}{}); err == nil {
+		t.Error("expected error from failure response")
+	}
+}
+
+func writePlugin(t *testing.T, dir, name, manifestTOML string) {
+	t.Helper()
+	pluginDir := filepath.Join(dir, name)
+	if err := os.MkdirAll(pluginDir, 0755); err != nil {
+		t.Fatalf("failed to create plugin dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(pluginDir, "plugin.toml"), []byte(manifestTOML), 0644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+}
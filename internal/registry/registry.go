@@ -31,6 +31,13 @@ type Registry struct {
 
 // New creates a new Registry with the given cache directory
 func New(cacheDir string) *Registry {
+	return NewWithClient(cacheDir, &http.Client{Timeout: fetchTimeout})
+}
+
+// NewWithClient creates a new Registry with the given cache directory and
+// HTTP client, letting embedders supply their own timeout, transport, or
+// round trippers instead of the fetchTimeout default New uses.
+func NewWithClient(cacheDir string, client *http.Client) *Registry {
 	baseURL := os.Getenv(EnvRegistryURL)
 	if baseURL == "" {
 		baseURL = DefaultRegistryURL
@@ -39,9 +46,7 @@ func New(cacheDir string) *Registry {
 	return &Registry{
 		BaseURL:  baseURL,
 		CacheDir: cacheDir,
-		client: &http.Client{
-			Timeout: fetchTimeout,
-		},
+		client:   client,
 	}
 }
 
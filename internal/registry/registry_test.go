@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestRecipeURL(t *testing.T) {
@@ -195,6 +196,21 @@ func TestEnvironmentVariableOverride(t *testing.T) {
 	}
 }
 
+func TestNewWithClient(t *testing.T) {
+	customClient := &http.Client{Timeout: 5 * time.Second}
+	reg := NewWithClient("/tmp/test-cache", customClient)
+
+	if reg.client != customClient {
+		t.Errorf("Registry client = %v, want the supplied client %v", reg.client, customClient)
+	}
+	if reg.CacheDir != "/tmp/test-cache" {
+		t.Errorf("Registry CacheDir = %q, want %q", reg.CacheDir, "/tmp/test-cache")
+	}
+	if reg.BaseURL != DefaultRegistryURL {
+		t.Errorf("Registry BaseURL = %q, want %q", reg.BaseURL, DefaultRegistryURL)
+	}
+}
+
 func TestFetchRecipeContextCancellation(t *testing.T) {
 	// Create a slow server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,126 @@
+package sbom
+
+import "time"
+
+const cycloneDXSpecVersion = "1.5"
+
+// cycloneDXHashAlgorithms maps executor.HashAlgorithm names to the "alg"
+// values CycloneDX's hash-alg enum expects.
+var cycloneDXHashAlgorithms = map[string]string{
+	"sha256": "SHA-256",
+	"sha512": "SHA-512",
+	"blake3": "BLAKE3",
+}
+
+// CycloneDXDocument is the subset of the CycloneDX 1.5 JSON schema tsuku
+// emits: a metadata component describing the tsuku environment, a component
+// per installed tool/library, and a dependency graph linking them.
+type CycloneDXDocument struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	Version      int                   `json:"version"`
+	Metadata     CycloneDXMetadata     `json:"metadata"`
+	Components   []CycloneDXComponent  `json:"components"`
+	Dependencies []CycloneDXDependency `json:"dependencies,omitempty"`
+}
+
+// CycloneDXMetadata describes when the BOM was generated and the root
+// component (the tsuku environment) it describes.
+type CycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Component CycloneDXComponent `json:"component"`
+}
+
+// CycloneDXComponent describes one component: the synthetic root, or an
+// installed Component.
+type CycloneDXComponent struct {
+	Type               string                       `json:"type"`
+	BOMRef             string                       `json:"bom-ref"`
+	Name               string                       `json:"name"`
+	Version            string                       `json:"version,omitempty"`
+	Supplier           *CycloneDXSupplier           `json:"supplier,omitempty"`
+	Hashes             []CycloneDXHash              `json:"hashes,omitempty"`
+	ExternalReferences []CycloneDXExternalReference `json:"externalReferences,omitempty"`
+}
+
+// CycloneDXSupplier names the organization or individual a component's
+// Component.Supplier proxies for.
+type CycloneDXSupplier struct {
+	Name string `json:"name"`
+}
+
+// CycloneDXHash is a single digest in CycloneDX's alg/content shape.
+type CycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+// CycloneDXExternalReference points at an external resource for a
+// component, e.g. the URL it was downloaded from.
+type CycloneDXExternalReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// CycloneDXDependency records which bom-refs a component depends on.
+type CycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// BuildCycloneDX renders env as a CycloneDX 1.5 document: a root
+// "application" component named "tsuku-env-<hostname>" that depends on a
+// component for every installed Component.
+func BuildCycloneDX(env Environment, createdAt time.Time) *CycloneDXDocument {
+	rootRef := envID(env.Hostname)
+
+	doc := &CycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: cycloneDXSpecVersion,
+		Version:     1,
+		Metadata: CycloneDXMetadata{
+			Timestamp: createdAt.UTC().Format(time.RFC3339),
+			Component: CycloneDXComponent{
+				Type:   "application",
+				BOMRef: rootRef,
+				Name:   rootRef,
+			},
+		},
+	}
+
+	rootDeps := CycloneDXDependency{Ref: rootRef}
+
+	for _, c := range env.Components {
+		ref := id(c.Name, c.Version)
+		comp := CycloneDXComponent{
+			Type:    "application",
+			BOMRef:  ref,
+			Name:    c.Name,
+			Version: c.Version,
+		}
+		if c.Supplier != "" {
+			comp.Supplier = &CycloneDXSupplier{Name: c.Supplier}
+		}
+		if c.DownloadLocation != "" {
+			comp.ExternalReferences = append(comp.ExternalReferences, CycloneDXExternalReference{
+				Type: "distribution",
+				URL:  c.DownloadLocation,
+			})
+		}
+		for _, cs := range c.Checksums {
+			alg, ok := cycloneDXHashAlgorithms[cs.Algorithm]
+			if !ok {
+				continue
+			}
+			comp.Hashes = append(comp.Hashes, CycloneDXHash{Alg: alg, Content: cs.Value})
+		}
+
+		doc.Components = append(doc.Components, comp)
+		rootDeps.DependsOn = append(rootDeps.DependsOn, ref)
+		doc.Dependencies = append(doc.Dependencies, CycloneDXDependency{Ref: ref})
+	}
+
+	doc.Dependencies = append([]CycloneDXDependency{rootDeps}, doc.Dependencies...)
+
+	return doc
+}
@@ -0,0 +1,82 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildCycloneDX_RootDependsOnComponents(t *testing.T) {
+	env := Environment{
+		Hostname: "dev-box",
+		Components: []Component{
+			{
+				Name:             "jq",
+				Version:          "1.7",
+				DownloadLocation: "https://github.com/jqlang/jq/releases/download/jq-1.7/jq.tar.gz",
+				Checksums:        []Checksum{{Algorithm: "sha256", Value: "deadbeef"}},
+				Supplier:         "https://github.com/jqlang/jq",
+			},
+		},
+	}
+
+	doc := BuildCycloneDX(env, time.Unix(0, 0))
+
+	if doc.BOMFormat != "CycloneDX" || doc.SpecVersion != cycloneDXSpecVersion {
+		t.Fatalf("unexpected document header: %+v", doc)
+	}
+	if doc.Metadata.Component.Name != "tsuku-env-dev-box" {
+		t.Errorf("Metadata.Component.Name = %q, want %q", doc.Metadata.Component.Name, "tsuku-env-dev-box")
+	}
+	if len(doc.Components) != 1 {
+		t.Fatalf("len(Components) = %d, want 1", len(doc.Components))
+	}
+
+	comp := doc.Components[0]
+	if comp.Name != "jq" || comp.Version != "1.7" {
+		t.Errorf("component = %+v, want jq 1.7", comp)
+	}
+	if len(comp.Hashes) != 1 || comp.Hashes[0].Alg != "SHA-256" || comp.Hashes[0].Content != "deadbeef" {
+		t.Errorf("Hashes = %+v, want one SHA-256 hash", comp.Hashes)
+	}
+	if len(comp.ExternalReferences) != 1 || comp.ExternalReferences[0].URL != env.Components[0].DownloadLocation {
+		t.Errorf("ExternalReferences = %+v", comp.ExternalReferences)
+	}
+	if comp.Supplier == nil || comp.Supplier.Name != env.Components[0].Supplier {
+		t.Errorf("Supplier = %+v, want %q", comp.Supplier, env.Components[0].Supplier)
+	}
+
+	if len(doc.Dependencies) != 2 {
+		t.Fatalf("len(Dependencies) = %d, want 2 (root + jq)", len(doc.Dependencies))
+	}
+	root := doc.Dependencies[0]
+	if root.Ref != doc.Metadata.Component.BOMRef || len(root.DependsOn) != 1 || root.DependsOn[0] != comp.BOMRef {
+		t.Errorf("root dependency = %+v, want DependsOn = [%q]", root, comp.BOMRef)
+	}
+}
+
+func TestBuildCycloneDX_BOMRefsAreDeterministic(t *testing.T) {
+	env := Environment{Hostname: "h", Components: []Component{{Name: "jq", Version: "1.7"}}}
+
+	first := BuildCycloneDX(env, time.Unix(0, 0))
+	second := BuildCycloneDX(env, time.Unix(100, 0))
+
+	if first.Components[0].BOMRef != second.Components[0].BOMRef {
+		t.Errorf("bom-ref changed across builds: %q vs %q", first.Components[0].BOMRef, second.Components[0].BOMRef)
+	}
+}
+
+func TestBuildCycloneDX_UnknownHashAlgorithmSkipped(t *testing.T) {
+	env := Environment{
+		Hostname: "h",
+		Components: []Component{{
+			Name:      "tool",
+			Version:   "1.0",
+			Checksums: []Checksum{{Algorithm: "md5", Value: "x"}},
+		}},
+	}
+
+	doc := BuildCycloneDX(env, time.Unix(0, 0))
+	if len(doc.Components[0].Hashes) != 0 {
+		t.Errorf("Hashes = %+v, want none for an unsupported algorithm", doc.Components[0].Hashes)
+	}
+}
@@ -0,0 +1,80 @@
+// Package sbom builds Software Bill of Materials documents describing the
+// tools and libraries a tsuku installation has in place, for supply-chain
+// auditing of the local environment. It supports the two formats most
+// vulnerability-scanning pipelines consume: SPDX and CycloneDX.
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tsukumogami/tsuku/internal/executor"
+)
+
+// Checksum is a single digest recorded against a Component, in the plain
+// algorithm/hex-value shape both SPDX and CycloneDX checksums derive from.
+type Checksum struct {
+	Algorithm string // e.g. "sha256", matching executor.HashAlgorithm
+	Value     string // hex-encoded digest
+}
+
+// Component describes one installed tool or library for SBOM purposes.
+// Callers build these from store state rather than re-resolving recipes,
+// so DownloadLocation and Checksums come from the tool's stored install
+// Plan and Supplier is best-effort (empty when recipe metadata wasn't
+// available at generation time).
+type Component struct {
+	Name               string
+	Version            string
+	DownloadLocation   string // source URL the package was fetched from, if known
+	Checksums          []Checksum
+	Supplier           string // e.g. a recipe's homepage; empty if unknown
+	IsSystemDependency bool   // installed by tsuku for internal use rather than requested directly
+}
+
+// Environment is the root of an SBOM: the tsuku installation itself,
+// described as a single synthetic component that depends on every
+// installed Component.
+type Environment struct {
+	Hostname   string
+	Components []Component
+}
+
+// id derives a stable identifier for a component from sha256(name@version),
+// so the same install produces the same identifier across regenerations
+// instead of a new random one each time.
+func id(name, version string) string {
+	sum := sha256.Sum256([]byte(name + "@" + version))
+	return hex.EncodeToString(sum[:])
+}
+
+// envID derives the synthetic root document's identifier from its hostname.
+func envID(hostname string) string {
+	return fmt.Sprintf("tsuku-env-%s", hostname)
+}
+
+// ChecksumsFromPlanSteps extracts the checksums recorded against a stored
+// Plan's steps (install.PlanStep.Checksum strings), parsing each "algo:hex"
+// value with executor.ParseHash and skipping steps that didn't record one
+// (e.g. a create_symlink step) or whose value fails to parse.
+func ChecksumsFromPlanSteps(stepChecksums []string) []Checksum {
+	seen := make(map[string]bool)
+	var checksums []Checksum
+	for _, raw := range stepChecksums {
+		if raw == "" {
+			continue
+		}
+		h, err := executor.ParseHash(raw)
+		if err != nil || h.IsZero() {
+			continue
+		}
+		key := string(h.Algorithm) + ":" + h.Value
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		checksums = append(checksums, Checksum{Algorithm: string(h.Algorithm), Value: h.Value})
+	}
+	return checksums
+}
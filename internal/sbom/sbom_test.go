@@ -0,0 +1,38 @@
+package sbom
+
+import "testing"
+
+func TestChecksumsFromPlanSteps_DedupesAndSkipsInvalid(t *testing.T) {
+	checksums := ChecksumsFromPlanSteps([]string{
+		"sha256:" + validHex(64),
+		"",
+		"sha256:" + validHex(64), // duplicate
+		"not-a-valid-checksum",
+	})
+
+	if len(checksums) != 1 {
+		t.Fatalf("len(checksums) = %d, want 1, got %+v", len(checksums), checksums)
+	}
+	if checksums[0].Algorithm != "sha256" {
+		t.Errorf("Algorithm = %q, want %q", checksums[0].Algorithm, "sha256")
+	}
+}
+
+func TestID_IsStableAndDiffersByInput(t *testing.T) {
+	if id("jq", "1.7") != id("jq", "1.7") {
+		t.Error("id() is not deterministic for the same input")
+	}
+	if id("jq", "1.7") == id("jq", "1.8") {
+		t.Error("id() should differ for different versions")
+	}
+}
+
+// validHex returns a string of n lowercase hex digits for building
+// syntactically valid test checksums without hardcoding a magic digest.
+func validHex(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = "0123456789abcdef"[i%16]
+	}
+	return string(b)
+}
@@ -0,0 +1,150 @@
+package sbom
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	spdxVersion     = "SPDX-2.3"
+	spdxDataLicense = "CC0-1.0"
+	spdxNoAssertion = "NOASSERTION"
+)
+
+// SPDXDocument is the subset of the SPDX 2.3 JSON schema tsuku emits: enough
+// for `tsuku list --format spdx-json` to produce a document that validates
+// against the schema, without the optional fields tsuku has no data for
+// (file-level detail, license scanning results).
+type SPDXDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      SPDXCreationInfo   `json:"creationInfo"`
+	Packages          []SPDXPackage      `json:"packages"`
+	Relationships     []SPDXRelationship `json:"relationships"`
+}
+
+// SPDXCreationInfo records who/what produced the document and when.
+type SPDXCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+// SPDXPackage describes one package (the synthetic root, or an installed
+// Component) in SPDX's package-level terms.
+type SPDXPackage struct {
+	SPDXID           string         `json:"SPDXID"`
+	Name             string         `json:"name"`
+	VersionInfo      string         `json:"versionInfo"`
+	DownloadLocation string         `json:"downloadLocation"`
+	Checksums        []SPDXChecksum `json:"checksums,omitempty"`
+	Supplier         string         `json:"supplier,omitempty"`
+	Originator       string         `json:"originator,omitempty"`
+	FilesAnalyzed    bool           `json:"filesAnalyzed"`
+	LicenseConcluded string         `json:"licenseConcluded"`
+	LicenseDeclared  string         `json:"licenseDeclared"`
+	CopyrightText    string         `json:"copyrightText"`
+}
+
+// SPDXChecksum is a single digest in SPDX's algorithm/checksumValue shape.
+type SPDXChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// SPDXRelationship links two SPDXID elements, e.g. the document DESCRIBES
+// the root package, which DEPENDS_ON each installed package.
+type SPDXRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// BuildSPDX renders env as an SPDX 2.3 document: a synthetic root package
+// named "tsuku-env-<hostname>" that the document DESCRIBES, with a
+// DEPENDS_ON relationship and package entry for every installed Component.
+func BuildSPDX(env Environment, createdAt time.Time) *SPDXDocument {
+	docName := envID(env.Hostname)
+	rootID := "SPDXRef-Package-" + id(docName, "")
+
+	doc := &SPDXDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       spdxDataLicense,
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              docName,
+		DocumentNamespace: fmt.Sprintf("https://tsuku.dev/spdx/%s-%s", docName, id(docName, createdAt.UTC().Format(time.RFC3339))),
+		CreationInfo: SPDXCreationInfo{
+			Created:  createdAt.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: tsuku"},
+		},
+		Packages: []SPDXPackage{{
+			SPDXID:           rootID,
+			Name:             docName,
+			VersionInfo:      spdxNoAssertion,
+			DownloadLocation: spdxNoAssertion,
+			FilesAnalyzed:    false,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+		}},
+		Relationships: []SPDXRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: rootID,
+		}},
+	}
+
+	for _, c := range env.Components {
+		pkgID := "SPDXRef-Package-" + id(c.Name, c.Version)
+		pkg := SPDXPackage{
+			SPDXID:           pkgID,
+			Name:             c.Name,
+			VersionInfo:      c.Version,
+			DownloadLocation: orNoAssertion(c.DownloadLocation),
+			FilesAnalyzed:    false,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+		}
+		if c.Supplier != "" {
+			pkg.Supplier = formatSPDXEntity(c.Supplier)
+			pkg.Originator = pkg.Supplier
+		}
+		for _, cs := range c.Checksums {
+			pkg.Checksums = append(pkg.Checksums, SPDXChecksum{
+				Algorithm:     strings.ToUpper(cs.Algorithm),
+				ChecksumValue: cs.Value,
+			})
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, SPDXRelationship{
+			SPDXElementID:      rootID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: pkgID,
+		})
+	}
+
+	return doc
+}
+
+func orNoAssertion(s string) string {
+	if s == "" {
+		return spdxNoAssertion
+	}
+	return s
+}
+
+// formatSPDXEntity renders a supplier/originator value in the
+// "Organization: <name>" / "Person: <name>" / "NOASSERTION" form SPDX 2.3
+// requires. tsuku's recipe metadata never distinguishes a person from an
+// organization, so a known value (e.g. a project homepage URL) is always
+// reported as an Organization.
+func formatSPDXEntity(s string) string {
+	if s == "" {
+		return spdxNoAssertion
+	}
+	return "Organization: " + s
+}
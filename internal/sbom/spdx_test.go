@@ -0,0 +1,97 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildSPDX_RootDescribesComponents(t *testing.T) {
+	env := Environment{
+		Hostname: "dev-box",
+		Components: []Component{
+			{
+				Name:             "ripgrep",
+				Version:          "14.1.0",
+				DownloadLocation: "https://github.com/BurntSushi/ripgrep/releases/download/14.1.0/ripgrep.tar.gz",
+				Checksums:        []Checksum{{Algorithm: "sha256", Value: "abc123"}},
+				Supplier:         "https://github.com/BurntSushi/ripgrep",
+			},
+		},
+	}
+
+	doc := BuildSPDX(env, time.Unix(0, 0))
+
+	if doc.SPDXVersion != spdxVersion {
+		t.Errorf("SPDXVersion = %q, want %q", doc.SPDXVersion, spdxVersion)
+	}
+	if len(doc.Packages) != 2 {
+		t.Fatalf("len(Packages) = %d, want 2 (root + ripgrep)", len(doc.Packages))
+	}
+
+	root := doc.Packages[0]
+	if root.Name != "tsuku-env-dev-box" {
+		t.Errorf("root package Name = %q, want %q", root.Name, "tsuku-env-dev-box")
+	}
+
+	pkg := doc.Packages[1]
+	if pkg.Name != "ripgrep" || pkg.VersionInfo != "14.1.0" {
+		t.Errorf("component package = %+v, want ripgrep 14.1.0", pkg)
+	}
+	if pkg.DownloadLocation != env.Components[0].DownloadLocation {
+		t.Errorf("DownloadLocation = %q, want %q", pkg.DownloadLocation, env.Components[0].DownloadLocation)
+	}
+	if len(pkg.Checksums) != 1 || pkg.Checksums[0].Algorithm != "SHA256" || pkg.Checksums[0].ChecksumValue != "abc123" {
+		t.Errorf("Checksums = %+v, want one SHA256 checksum", pkg.Checksums)
+	}
+	wantSupplier := "Organization: " + env.Components[0].Supplier
+	if pkg.Supplier != wantSupplier {
+		t.Errorf("Supplier = %q, want %q", pkg.Supplier, wantSupplier)
+	}
+	if pkg.Originator != wantSupplier {
+		t.Errorf("Originator = %q, want %q", pkg.Originator, wantSupplier)
+	}
+
+	var describesRoot, dependsOnRipgrep bool
+	for _, rel := range doc.Relationships {
+		if rel.SPDXElementID == "SPDXRef-DOCUMENT" && rel.RelationshipType == "DESCRIBES" && rel.RelatedSPDXElement == root.SPDXID {
+			describesRoot = true
+		}
+		if rel.SPDXElementID == root.SPDXID && rel.RelationshipType == "DEPENDS_ON" && rel.RelatedSPDXElement == pkg.SPDXID {
+			dependsOnRipgrep = true
+		}
+	}
+	if !describesRoot {
+		t.Error("expected document to DESCRIBES the root package")
+	}
+	if !dependsOnRipgrep {
+		t.Error("expected root package to DEPENDS_ON the ripgrep package")
+	}
+}
+
+func TestBuildSPDX_MissingFieldsAreNoAssertion(t *testing.T) {
+	env := Environment{
+		Hostname:   "dev-box",
+		Components: []Component{{Name: "curl", Version: "8.0.0"}},
+	}
+
+	doc := BuildSPDX(env, time.Unix(0, 0))
+	pkg := doc.Packages[1]
+
+	if pkg.DownloadLocation != spdxNoAssertion {
+		t.Errorf("DownloadLocation = %q, want %q", pkg.DownloadLocation, spdxNoAssertion)
+	}
+	if pkg.Supplier != "" {
+		t.Errorf("Supplier = %q, want empty when unknown", pkg.Supplier)
+	}
+}
+
+func TestBuildSPDX_PackageIDsAreDeterministic(t *testing.T) {
+	env := Environment{Hostname: "h", Components: []Component{{Name: "jq", Version: "1.7"}}}
+
+	first := BuildSPDX(env, time.Unix(0, 0))
+	second := BuildSPDX(env, time.Unix(100, 0))
+
+	if first.Packages[1].SPDXID != second.Packages[1].SPDXID {
+		t.Errorf("SPDXID changed across builds: %q vs %q", first.Packages[1].SPDXID, second.Packages[1].SPDXID)
+	}
+}
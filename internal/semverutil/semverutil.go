@@ -0,0 +1,31 @@
+// Package semverutil provides small helpers shared by the packages that
+// compare installed or detected version strings against semver constraints
+// (internal/install's prune selector, cmd/tsuku's dependency verification).
+package semverutil
+
+import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// CoerceVersion parses an installed or detected version string into a
+// semver.Version, tolerating the common deviations real-world version
+// strings exhibit: a leading "v" prefix (handled by semver.NewVersion
+// itself) and two-part versions (e.g. "1.20") which get zero-padded to a
+// valid patch version ("1.20.0") before parsing.
+func CoerceVersion(raw string) (*semver.Version, error) {
+	v := strings.TrimSpace(raw)
+
+	mainPart := v
+	if idx := strings.IndexAny(v, "-+"); idx != -1 {
+		mainPart = v[:idx]
+	}
+	mainPart = strings.TrimPrefix(mainPart, "v")
+	if strings.Count(mainPart, ".") == 1 {
+		v = strings.TrimPrefix(v, "v")
+		v = mainPart + ".0" + v[len(mainPart):]
+	}
+
+	return semver.NewVersion(v)
+}
@@ -0,0 +1,40 @@
+package semverutil
+
+import "testing"
+
+func TestCoerceVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{"full version", "1.2.3", "1.2.3", false},
+		{"v prefix", "v1.2.3", "1.2.3", false},
+		{"two-part zero-padded", "1.20", "1.20.0", false},
+		{"two-part with v prefix", "v1.20", "1.20.0", false},
+		{"two-part with prerelease", "1.20-beta", "1.20.0-beta", false},
+		{"invalid", "not-a-version", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := CoerceVersion(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("CoerceVersion(%q) error = nil, want error", tt.raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CoerceVersion(%q) error = %v", tt.raw, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("CoerceVersion(%q) = %q, want %q", tt.raw, got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/tsukumogami/tsuku/internal/config"
+	"github.com/tsukumogami/tsuku/internal/notify"
 )
 
 // Config represents user-configurable settings.
@@ -21,6 +22,15 @@ type Config struct {
 
 	// LLM contains LLM-related configuration.
 	LLM LLMConfig `toml:"llm"`
+
+	// Notifications lists external endpoints (CI dashboards, Slack bots,
+	// audit logs) that receive installation lifecycle events.
+	Notifications []notify.Endpoint `toml:"notifications,omitempty"`
+
+	// RequireSignedPlans makes plan execution refuse any plan file without
+	// a verified signature, regardless of what trust.toml's per-source
+	// policy requires. Default is false.
+	RequireSignedPlans bool `toml:"require_signed_plans"`
 }
 
 // LLMConfig holds LLM-specific settings.
@@ -133,6 +143,8 @@ func (c *Config) Get(key string) (string, bool) {
 			return "", true
 		}
 		return strings.Join(c.LLM.Providers, ","), true
+	case "require_signed_plans":
+		return strconv.FormatBool(c.RequireSignedPlans), true
 	default:
 		return "", false
 	}
@@ -167,6 +179,13 @@ func (c *Config) Set(key, value string) error {
 		}
 		c.LLM.Providers = providers
 		return nil
+	case "require_signed_plans":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for require_signed_plans: must be true or false")
+		}
+		c.RequireSignedPlans = b
+		return nil
 	default:
 		return fmt.Errorf("unknown config key: %s", key)
 	}
@@ -175,8 +194,9 @@ func (c *Config) Set(key, value string) error {
 // AvailableKeys returns a list of all configurable keys with descriptions.
 func AvailableKeys() map[string]string {
 	return map[string]string{
-		"telemetry":     "Enable anonymous usage statistics (true/false)",
-		"llm.enabled":   "Enable LLM features for recipe generation (true/false)",
-		"llm.providers": "Preferred LLM provider order (comma-separated, e.g., claude,gemini)",
+		"telemetry":            "Enable anonymous usage statistics (true/false)",
+		"llm.enabled":          "Enable LLM features for recipe generation (true/false)",
+		"llm.providers":        "Preferred LLM provider order (comma-separated, e.g., claude,gemini)",
+		"require_signed_plans": "Refuse to execute plan files without a verified signature (true/false)",
 	}
 }
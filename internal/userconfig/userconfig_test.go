@@ -703,3 +703,56 @@ func TestAvailableKeysIncludesBudgetSettings(t *testing.T) {
 		t.Error("expected llm.hourly_rate_limit in available keys")
 	}
 }
+
+func TestGetRequireSignedPlans(t *testing.T) {
+	cfg := DefaultConfig()
+	val, ok := cfg.Get("require_signed_plans")
+	if !ok {
+		t.Error("expected require_signed_plans key to exist")
+	}
+	if val != "false" {
+		t.Errorf("expected 'false', got %q", val)
+	}
+
+	cfg.RequireSignedPlans = true
+	val, ok = cfg.Get("require_signed_plans")
+	if !ok {
+		t.Error("expected require_signed_plans key to exist")
+	}
+	if val != "true" {
+		t.Errorf("expected 'true', got %q", val)
+	}
+}
+
+func TestSetRequireSignedPlans(t *testing.T) {
+	cfg := DefaultConfig()
+
+	if err := cfg.Set("require_signed_plans", "true"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cfg.RequireSignedPlans {
+		t.Error("expected RequireSignedPlans=true")
+	}
+
+	if err := cfg.Set("require_signed_plans", "false"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RequireSignedPlans {
+		t.Error("expected RequireSignedPlans=false")
+	}
+}
+
+func TestSetRequireSignedPlansInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+
+	if err := cfg.Set("require_signed_plans", "invalid"); err == nil {
+		t.Error("expected error for invalid boolean value")
+	}
+}
+
+func TestAvailableKeysIncludesRequireSignedPlans(t *testing.T) {
+	keys := AvailableKeys()
+	if _, ok := keys["require_signed_plans"]; !ok {
+		t.Error("expected require_signed_plans in available keys")
+	}
+}
@@ -1,9 +1,11 @@
 package version
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/tsukumogami/tsuku/internal/discover"
 	"github.com/tsukumogami/tsuku/internal/recipe"
 )
 
@@ -11,7 +13,8 @@ import (
 // This enables the recipe package to validate version configuration without
 // maintaining duplicate lists of known sources.
 type FactoryValidator struct {
-	factory *ProviderFactory
+	factory       *ProviderFactory
+	sourceManager *discover.SourceManager
 }
 
 // NewFactoryValidator creates a validator backed by the given factory.
@@ -19,6 +22,16 @@ func NewFactoryValidator(factory *ProviderFactory) *FactoryValidator {
 	return &FactoryValidator{factory: factory}
 }
 
+// WithSourceManager configures the validator to confirm, via sm, that a
+// matched source actually exists upstream (currently only implemented for
+// npm, the one ecosystem with a registered discover.SourceDriver) instead of
+// only checking that a strategy could in principle handle the recipe. Pass
+// nil to go back to strategy-only validation.
+func (v *FactoryValidator) WithSourceManager(sm *discover.SourceManager) *FactoryValidator {
+	v.sourceManager = sm
+	return v
+}
+
 // CanResolveVersion returns true if a version provider can be created for this recipe.
 func (v *FactoryValidator) CanResolveVersion(r *recipe.Recipe) bool {
 	for _, strategy := range v.factory.strategies {
@@ -55,6 +68,9 @@ func (v *FactoryValidator) KnownSources() []string {
 func (v *FactoryValidator) ValidateVersionConfig(r *recipe.Recipe) error {
 	// Check if any strategy can handle this recipe
 	if v.CanResolveVersion(r) {
+		if err := v.checkSourceExists(r); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -90,12 +106,63 @@ func (v *FactoryValidator) ValidateVersionConfig(r *recipe.Recipe) error {
 	return nil
 }
 
+// checkSourceExists consults the configured discover.SourceManager, when
+// one is set, to confirm a matched source is actually published upstream.
+// It is a no-op (nil error) when no SourceManager is configured, or when
+// the recipe's source has no registered SourceDriver yet; network errors
+// are treated as soft failures so validation stays usable offline.
+func (v *FactoryValidator) checkSourceExists(r *recipe.Recipe) error {
+	if v.sourceManager == nil || r.Version.Source != "npm" {
+		return nil
+	}
+
+	pkg := npmPackageParam(r)
+	if pkg == "" {
+		return nil
+	}
+
+	_, err := v.sourceManager.ListVersions(context.Background(), "npm", pkg)
+	if err != nil && strings.Contains(err.Error(), "package not found") {
+		return fmt.Errorf("npm package %q not found: %w", pkg, err)
+	}
+	// Any other error (network unavailable, rate limited, cache-write
+	// failure, ...) is a soft failure: validation should still work offline.
+	return nil
+}
+
+// npmPackageParam returns the "package" parameter of the recipe's
+// npm_install step, or "" if there isn't one.
+func npmPackageParam(r *recipe.Recipe) string {
+	for _, step := range r.Steps {
+		if step.Action != "npm_install" {
+			continue
+		}
+		if pkg, ok := step.Params["package"].(string); ok {
+			return pkg
+		}
+	}
+	return ""
+}
+
 // defaultFactory is the singleton factory used for registration
 var defaultFactory = NewProviderFactory()
 
+// defaultValidator is the singleton validator registered with the recipe
+// package; ConfigureSourceManager mutates it after startup once a cache
+// directory is known.
+var defaultValidator = NewFactoryValidator(defaultFactory)
+
 // init registers the FactoryValidator with the recipe package at startup.
 // This enables the recipe package to validate version configuration without
 // importing the version package (breaking the circular dependency).
 func init() {
-	recipe.SetVersionValidator(NewFactoryValidator(defaultFactory))
+	recipe.SetVersionValidator(defaultValidator)
+}
+
+// ConfigureSourceManager wires sm into the registered validator so
+// ValidateVersionConfig can confirm matched sources exist upstream rather
+// than only checking that a strategy could in principle handle them. Call
+// once at startup, after the cache directory is known; pass nil to disable.
+func ConfigureSourceManager(sm *discover.SourceManager) {
+	defaultValidator.WithSourceManager(sm)
 }
@@ -1,8 +1,11 @@
 package version
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/tsukumogami/tsuku/internal/discover"
 	"github.com/tsukumogami/tsuku/internal/recipe"
 )
 
@@ -195,3 +198,67 @@ func TestFactoryValidator_Registration(t *testing.T) {
 		t.Error("registered validator should be able to resolve github_repo")
 	}
 }
+
+// fakeNpmDriver is a minimal discover.SourceDriver test double so this
+// package doesn't need network access to exercise checkSourceExists.
+type fakeNpmDriver struct {
+	versions []string
+	err      error
+}
+
+func (d *fakeNpmDriver) ListVersions(ctx context.Context, source string) ([]string, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.versions, nil
+}
+
+func (d *fakeNpmDriver) GetManifest(ctx context.Context, source, version string) (discover.Manifest, error) {
+	return discover.Manifest{}, fmt.Errorf("not implemented")
+}
+
+func npmRecipe(pkg string) *recipe.Recipe {
+	return &recipe.Recipe{
+		Version: recipe.VersionSection{Source: "npm"},
+		Steps: []recipe.Step{
+			{Action: "npm_install", Params: map[string]interface{}{"package": pkg}},
+		},
+	}
+}
+
+func TestFactoryValidator_ValidateVersionConfig_SourceManagerConfirmsExistence(t *testing.T) {
+	factory := NewProviderFactory()
+	sm := discover.NewSourceManager(discover.SourceManagerConfig{CacheDir: t.TempDir()}, map[string]discover.SourceDriver{
+		"npm": &fakeNpmDriver{versions: []string{"1.0.0"}},
+	})
+	validator := NewFactoryValidator(factory).WithSourceManager(sm)
+
+	if err := validator.ValidateVersionConfig(npmRecipe("left-pad")); err != nil {
+		t.Errorf("expected no error for existing package, got %v", err)
+	}
+}
+
+func TestFactoryValidator_ValidateVersionConfig_SourceManagerRejectsMissingPackage(t *testing.T) {
+	factory := NewProviderFactory()
+	sm := discover.NewSourceManager(discover.SourceManagerConfig{CacheDir: t.TempDir()}, map[string]discover.SourceDriver{
+		"npm": &fakeNpmDriver{err: fmt.Errorf("npm: package not found: left-pad-typo")},
+	})
+	validator := NewFactoryValidator(factory).WithSourceManager(sm)
+
+	err := validator.ValidateVersionConfig(npmRecipe("left-pad-typo"))
+	if err == nil {
+		t.Fatal("expected error for nonexistent package")
+	}
+}
+
+func TestFactoryValidator_ValidateVersionConfig_SourceManagerSoftFailsOnNetworkError(t *testing.T) {
+	factory := NewProviderFactory()
+	sm := discover.NewSourceManager(discover.SourceManagerConfig{CacheDir: t.TempDir()}, map[string]discover.SourceDriver{
+		"npm": &fakeNpmDriver{err: fmt.Errorf("npm: failed to fetch left-pad: network unavailable")},
+	})
+	validator := NewFactoryValidator(factory).WithSourceManager(sm)
+
+	if err := validator.ValidateVersionConfig(npmRecipe("left-pad")); err != nil {
+		t.Errorf("expected network errors to be swallowed, got %v", err)
+	}
+}
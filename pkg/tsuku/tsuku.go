@@ -0,0 +1,159 @@
+// Package tsuku is the embeddable surface of tsuku: the smallest set of
+// types a downstream Go program needs to resolve and apply an installation
+// plan without going through the cobra CLI, in the same spirit as
+// controller-runtime's setup-envtest being usable as a plain package.
+//
+// It re-exports install.Options/Manager/InstalledTool and
+// executor.InstallationPlan under shorter names, and adds ApplyPlan, which
+// mirrors what `tsuku install --plan` does at the CLI layer: validate,
+// execute, and record the result, all without printing to stdout or calling
+// os.Exit.
+package tsuku
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tsukumogami/tsuku/internal/executor"
+	"github.com/tsukumogami/tsuku/internal/install"
+	"github.com/tsukumogami/tsuku/internal/recipe"
+	"github.com/tsukumogami/tsuku/internal/registry"
+)
+
+// Options configures a Manager. See install.Options for field documentation.
+type Options = install.Options
+
+// Hooks lets an embedder observe a Manager's operations. See install.Hooks.
+type Hooks = install.Hooks
+
+// InstalledTool describes a tool installed by a Manager. See install.InstalledTool.
+type InstalledTool = install.InstalledTool
+
+// Plan is a fully-resolved, deterministic installation plan, typically
+// produced by `tsuku eval` or the registry's cached plans. See
+// executor.InstallationPlan.
+type Plan = executor.InstallationPlan
+
+// Manager installs and tracks tools without depending on cobra or
+// package-level global config. Construct one with NewManager.
+type Manager struct {
+	install *install.Manager
+
+	// registry caches the Registry Resolve falls back to building when the
+	// Manager wasn't constructed with Options.Registry or Options.HTTPClient,
+	// so repeated Resolve calls reuse one HTTP client instead of allocating a
+	// new one every time.
+	registry *registry.Registry
+}
+
+// NewManager creates a Manager from Options, applying the same defaults
+// install.NewManager does for any field left unset.
+func NewManager(opts Options) (*Manager, error) {
+	mgr, err := install.NewManager(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{install: mgr}, nil
+}
+
+// List returns the tools currently installed, excluding hidden execution
+// dependencies.
+func (m *Manager) List() ([]InstalledTool, error) {
+	return m.install.List()
+}
+
+// Remove removes an installed tool.
+func (m *Manager) Remove(name string) error {
+	return m.install.Remove(name)
+}
+
+// Activate switches a tool's active version.
+func (m *Manager) Activate(name, version string) error {
+	return m.install.Activate(name, version)
+}
+
+// Resolve fetches a tool's recipe by name, using the Registry and
+// Config.RecipesDir this Manager was constructed with. Embedders that want
+// to generate their own Plan for a tool (rather than applying one produced
+// by `tsuku eval`) start here.
+func (m *Manager) Resolve(name string) (*recipe.Recipe, error) {
+	reg := m.install.Registry()
+	if reg == nil {
+		if m.registry == nil {
+			m.registry = registry.New(m.install.Config().RegistryDir)
+		}
+		reg = m.registry
+	}
+	loader := recipe.NewWithLocalRecipes(reg, m.install.Config().RecipesDir)
+	return loader.Get(name)
+}
+
+// ApplyPlan executes a fully-resolved Plan and installs its result to the
+// permanent tool location, the way `tsuku install --plan` does. toolName
+// overrides the plan's own Tool field when non-empty, matching the CLI's
+// `tsuku install <name> --plan plan.json` form.
+//
+// A plan containing only require_system steps validates that the system
+// dependency is present but installs nothing, and ApplyPlan returns nil
+// without recording any state for it.
+func (m *Manager) ApplyPlan(ctx context.Context, plan *Plan, toolName string) error {
+	if toolName != "" && toolName != plan.Tool {
+		return fmt.Errorf("plan is for tool '%s', but '%s' was specified", plan.Tool, toolName)
+	}
+	if err := executor.ValidatePlan(plan); err != nil {
+		return err
+	}
+
+	effectiveToolName := toolName
+	if effectiveToolName == "" {
+		effectiveToolName = plan.Tool
+	}
+
+	// The executor needs a recipe to set up paths, but the plan carries all
+	// the actual steps, so a minimal recipe naming the tool is sufficient.
+	minimalRecipe := &recipe.Recipe{
+		Metadata: recipe.MetadataSection{Name: effectiveToolName},
+	}
+
+	exec, err := executor.NewWithVersion(minimalRecipe, plan.Version)
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %w", err)
+	}
+	defer exec.Cleanup()
+
+	cfg := m.install.Config()
+	exec.SetDownloadCacheDir(cfg.DownloadCacheDir)
+	exec.SetToolsDir(cfg.ToolsDir)
+
+	if err := exec.ExecutePlan(ctx, plan); err != nil {
+		return fmt.Errorf("plan execution failed: %w", err)
+	}
+
+	if isSystemDependencyPlan(plan) {
+		return nil
+	}
+
+	installOpts := install.DefaultInstallOptions()
+	if err := m.install.InstallWithOptions(effectiveToolName, plan.Version, exec.WorkDir(), installOpts); err != nil {
+		return err
+	}
+
+	return m.install.GetState().UpdateTool(effectiveToolName, func(ts *install.ToolState) {
+		ts.IsExplicit = true
+	})
+}
+
+// isSystemDependencyPlan reports whether plan only validates a system
+// dependency rather than installing anything, mirroring the CLI's
+// isSystemDependencyRecipe check.
+func isSystemDependencyPlan(plan *Plan) bool {
+	if len(plan.Steps) == 0 {
+		return false
+	}
+	for _, step := range plan.Steps {
+		if step.Action != "require_system" {
+			return false
+		}
+	}
+	return true
+}
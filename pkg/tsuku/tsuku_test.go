@@ -0,0 +1,60 @@
+package tsuku
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tsukumogami/tsuku/internal/executor"
+	"github.com/tsukumogami/tsuku/internal/testutil"
+)
+
+func TestNewManager_List(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr, err := NewManager(Options{Config: cfg})
+	if err != nil {
+		t.Fatalf("NewManager() error: %v", err)
+	}
+
+	tools, err := mgr.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(tools) != 0 {
+		t.Errorf("List() on a fresh Manager = %v, want empty", tools)
+	}
+}
+
+func TestApplyPlan_ToolNameMismatch(t *testing.T) {
+	cfg, cleanup := testutil.NewTestConfig(t)
+	defer cleanup()
+
+	mgr, err := NewManager(Options{Config: cfg})
+	if err != nil {
+		t.Fatalf("NewManager() error: %v", err)
+	}
+
+	plan := &Plan{Tool: "python", Version: "3.12.0", Steps: []executor.ResolvedStep{{Action: "require_system"}}}
+	err = mgr.ApplyPlan(context.Background(), plan, "node")
+	if err == nil {
+		t.Fatal("ApplyPlan() expected an error for mismatched tool name, got nil")
+	}
+}
+
+func TestIsSystemDependencyPlan(t *testing.T) {
+	requireOnly := &Plan{Steps: []executor.ResolvedStep{{Action: "require_system"}}}
+	if !isSystemDependencyPlan(requireOnly) {
+		t.Error("isSystemDependencyPlan() = false, want true for a require_system-only plan")
+	}
+
+	mixed := &Plan{Steps: []executor.ResolvedStep{{Action: "require_system"}, {Action: "download"}}}
+	if isSystemDependencyPlan(mixed) {
+		t.Error("isSystemDependencyPlan() = true, want false when other actions are present")
+	}
+
+	empty := &Plan{}
+	if isSystemDependencyPlan(empty) {
+		t.Error("isSystemDependencyPlan() = true, want false for an empty plan")
+	}
+}